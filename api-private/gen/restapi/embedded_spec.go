@@ -77,6 +77,21 @@ func init() {
             "name": "state",
             "in": "query",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "code_challenge",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "code_challenge_method",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "nonce",
+            "in": "query"
           }
         ],
         "responses": {
@@ -89,103 +104,353 @@ func init() {
         }
       }
     },
-    "/clients": {},
-    "/scopes": {}
-  },
-  "definitions": {
-    "AuthorizationCode": {
-      "type": "object",
-      "properties": {
-        "code": {
-          "type": "string"
-        },
-        "expiresSeconds": {
-          "type": "integer",
-          "format": "int64"
-        }
-      }
-    }
-  },
-  "securityDefinitions": {
-    "Basic": {
-      "type": "basic"
-    }
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http",
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "title": "Oauth Private API",
-    "contact": {
-      "name": "mars"
-    },
-    "version": "v1"
-  },
-  "basePath": "/api-private/v1/oauth",
-  "paths": {
-    "/authorize": {
-      "post": {
-        "operationId": "Authorize",
+    "/clients": {
+      "get": {
+        "operationId": "ListClients",
         "parameters": [
           {
             "type": "string",
-            "name": "accountJwt",
+            "name": "account_id",
             "in": "query",
             "required": true
           },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "page",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "page_size",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Client"
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "operationId": "CreateClient",
+        "parameters": [
           {
             "type": "string",
-            "name": "response_type",
+            "name": "account_id",
             "in": "query",
             "required": true
           },
+          {
+            "type": "string",
+            "name": "redirect_uri",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "scope",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "client_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "grant_types",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "default_scope",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "access_token_ttl",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "refresh_token_ttl",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "issue_refresh_token",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "token_endpoint_auth_method",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "jwks",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "tls_client_auth_subject_dn",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/Client"
+            }
+          }
+        }
+      }
+    },
+    "/clients/{client_id}": {
+      "get": {
+        "operationId": "GetClient",
+        "parameters": [
           {
             "type": "string",
             "name": "client_id",
-            "in": "query",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/Client"
+            }
+          }
+        }
+      },
+      "delete": {
+        "operationId": "DeleteClient",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "client_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok"
+          }
+        }
+      },
+      "patch": {
+        "operationId": "UpdateClient",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "client_id",
+            "in": "path",
             "required": true
           },
           {
             "type": "string",
             "name": "redirect_uri",
-            "in": "query",
-            "required": true
+            "in": "query"
           },
           {
             "type": "string",
             "name": "scope",
-            "in": "query",
-            "required": true
+            "in": "query"
           },
           {
             "type": "string",
-            "name": "state",
+            "name": "client_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "grant_types",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "default_scope",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "access_token_ttl",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "refresh_token_ttl",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "issue_refresh_token",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "token_endpoint_auth_method",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "jwks",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "tls_client_auth_subject_dn",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/Client"
+            }
+          }
+        }
+      }
+    },
+    "/clients/{client_id}/secret": {
+      "post": {
+        "operationId": "RotateClientSecret",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "client_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/Client"
+            }
+          }
+        }
+      }
+    },
+    "/scopes": {
+      "get": {
+        "operationId": "ListScopes",
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Scope"
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "operationId": "CreateScope",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "scope",
             "in": "query",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "description",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "ok",
             "schema": {
-              "$ref": "#/definitions/AuthorizationCode"
+              "$ref": "#/definitions/Scope"
             }
           }
         }
       }
     },
-    "/clients": {},
-    "/scopes": {}
+    "/scopes/{scope}": {
+      "get": {
+        "operationId": "GetScope",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "scope",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/Scope"
+            }
+          }
+        }
+      },
+      "delete": {
+        "operationId": "DeleteScope",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "scope",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok"
+          }
+        }
+      },
+      "patch": {
+        "operationId": "UpdateScope",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "scope",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "description",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/Scope"
+            }
+          }
+        }
+      }
+    }
   },
   "definitions": {
     "AuthorizationCode": {
@@ -199,6 +464,595 @@ func init() {
           "format": "int64"
         }
       }
+    },
+    "Client": {
+      "type": "object",
+      "properties": {
+        "access_token_ttl": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "account_id": {
+          "type": "string"
+        },
+        "client_id": {
+          "type": "string"
+        },
+        "client_secret": {
+          "description": "only returned when the client is created or its secret is rotated",
+          "type": "string"
+        },
+        "client_type": {
+          "type": "string"
+        },
+        "default_scope": {
+          "type": "string"
+        },
+        "grant_types": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "issue_refresh_token": {
+          "type": "boolean"
+        },
+        "jwks": {
+          "type": "string"
+        },
+        "redirect_uris": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "refresh_token_ttl": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "scope": {
+          "type": "string"
+        },
+        "tls_client_auth_subject_dn": {
+          "type": "string"
+        },
+        "token_endpoint_auth_method": {
+          "type": "string"
+        }
+      }
+    },
+    "Scope": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "scope": {
+          "type": "string"
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "Basic": {
+      "type": "basic"
+    }
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "title": "Oauth Private API",
+    "contact": {
+      "name": "mars"
+    },
+    "version": "v1"
+  },
+  "basePath": "/api-private/v1/oauth",
+  "paths": {
+    "/authorize": {
+      "post": {
+        "operationId": "Authorize",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "accountJwt",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "response_type",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "client_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "redirect_uri",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "scope",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "state",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "code_challenge",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "code_challenge_method",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "nonce",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/AuthorizationCode"
+            }
+          }
+        }
+      }
+    },
+    "/clients": {
+      "get": {
+        "operationId": "ListClients",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "account_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "page",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "page_size",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Client"
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "operationId": "CreateClient",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "account_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "redirect_uri",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "scope",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "client_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "grant_types",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "default_scope",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "access_token_ttl",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "refresh_token_ttl",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "issue_refresh_token",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "token_endpoint_auth_method",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "jwks",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "tls_client_auth_subject_dn",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/Client"
+            }
+          }
+        }
+      }
+    },
+    "/clients/{client_id}": {
+      "get": {
+        "operationId": "GetClient",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "client_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/Client"
+            }
+          }
+        }
+      },
+      "delete": {
+        "operationId": "DeleteClient",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "client_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok"
+          }
+        }
+      },
+      "patch": {
+        "operationId": "UpdateClient",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "client_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "redirect_uri",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "scope",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "client_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "grant_types",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "default_scope",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "access_token_ttl",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "refresh_token_ttl",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "issue_refresh_token",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "token_endpoint_auth_method",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "jwks",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "tls_client_auth_subject_dn",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/Client"
+            }
+          }
+        }
+      }
+    },
+    "/clients/{client_id}/secret": {
+      "post": {
+        "operationId": "RotateClientSecret",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "client_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/Client"
+            }
+          }
+        }
+      }
+    },
+    "/scopes": {
+      "get": {
+        "operationId": "ListScopes",
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Scope"
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "operationId": "CreateScope",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "scope",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "description",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/Scope"
+            }
+          }
+        }
+      }
+    },
+    "/scopes/{scope}": {
+      "get": {
+        "operationId": "GetScope",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "scope",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/Scope"
+            }
+          }
+        }
+      },
+      "delete": {
+        "operationId": "DeleteScope",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "scope",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok"
+          }
+        }
+      },
+      "patch": {
+        "operationId": "UpdateScope",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "scope",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "description",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/Scope"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "AuthorizationCode": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "string"
+        },
+        "expiresSeconds": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "Client": {
+      "type": "object",
+      "properties": {
+        "access_token_ttl": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "account_id": {
+          "type": "string"
+        },
+        "client_id": {
+          "type": "string"
+        },
+        "client_secret": {
+          "description": "only returned when the client is created or its secret is rotated",
+          "type": "string"
+        },
+        "client_type": {
+          "type": "string"
+        },
+        "default_scope": {
+          "type": "string"
+        },
+        "grant_types": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "issue_refresh_token": {
+          "type": "boolean"
+        },
+        "jwks": {
+          "type": "string"
+        },
+        "redirect_uris": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "refresh_token_ttl": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "scope": {
+          "type": "string"
+        },
+        "tls_client_auth_subject_dn": {
+          "type": "string"
+        },
+        "token_endpoint_auth_method": {
+          "type": "string"
+        }
+      }
+    },
+    "Scope": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "scope": {
+          "type": "string"
+        }
+      }
     }
   },
   "securityDefinitions": {
@@ -42,6 +42,18 @@ type AuthorizeParams struct {
 	  In: query
 	*/
 	ClientID string
+	/*
+	  In: query
+	*/
+	CodeChallenge *string
+	/*
+	  In: query
+	*/
+	CodeChallengeMethod *string
+	/*
+	  In: query
+	*/
+	Nonce *string
 	/*
 	  Required: true
 	  In: query
@@ -85,6 +97,21 @@ func (o *AuthorizeParams) BindRequest(r *http.Request, route *middleware.Matched
 		res = append(res, err)
 	}
 
+	qCodeChallenge, qhkCodeChallenge, _ := qs.GetOK("code_challenge")
+	if err := o.bindCodeChallenge(qCodeChallenge, qhkCodeChallenge, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qCodeChallengeMethod, qhkCodeChallengeMethod, _ := qs.GetOK("code_challenge_method")
+	if err := o.bindCodeChallengeMethod(qCodeChallengeMethod, qhkCodeChallengeMethod, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qNonce, qhkNonce, _ := qs.GetOK("nonce")
+	if err := o.bindNonce(qNonce, qhkNonce, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qRedirectURI, qhkRedirectURI, _ := qs.GetOK("redirect_uri")
 	if err := o.bindRedirectURI(qRedirectURI, qhkRedirectURI, route.Formats); err != nil {
 		res = append(res, err)
@@ -151,6 +178,57 @@ func (o *AuthorizeParams) bindClientID(rawData []string, hasKey bool, formats st
 	return nil
 }
 
+func (o *AuthorizeParams) bindCodeChallenge(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.CodeChallenge = &raw
+
+	return nil
+}
+
+func (o *AuthorizeParams) bindCodeChallengeMethod(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.CodeChallengeMethod = &raw
+
+	return nil
+}
+
+func (o *AuthorizeParams) bindNonce(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Nonce = &raw
+
+	return nil
+}
+
 func (o *AuthorizeParams) bindRedirectURI(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	if !hasKey {
 		return errors.Required("redirect_uri", "query")
@@ -13,12 +13,15 @@ import (
 
 // AuthorizeURL generates an URL for the authorize operation
 type AuthorizeURL struct {
-	AccountJwt   string
-	ClientID     string
-	RedirectURI  string
-	ResponseType string
-	Scope        string
-	State        string
+	AccountJwt          string
+	ClientID            string
+	CodeChallenge       *string
+	CodeChallengeMethod *string
+	Nonce               *string
+	RedirectURI         string
+	ResponseType        string
+	Scope               string
+	State               string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -64,6 +67,30 @@ func (o *AuthorizeURL) Build() (*url.URL, error) {
 		qs.Set("client_id", clientID)
 	}
 
+	var codeChallenge string
+	if o.CodeChallenge != nil {
+		codeChallenge = *o.CodeChallenge
+	}
+	if codeChallenge != "" {
+		qs.Set("code_challenge", codeChallenge)
+	}
+
+	var codeChallengeMethod string
+	if o.CodeChallengeMethod != nil {
+		codeChallengeMethod = *o.CodeChallengeMethod
+	}
+	if codeChallengeMethod != "" {
+		qs.Set("code_challenge_method", codeChallengeMethod)
+	}
+
+	var nonce string
+	if o.Nonce != nil {
+		nonce = *o.Nonce
+	}
+	if nonce != "" {
+		qs.Set("nonce", nonce)
+	}
+
 	redirectURI := o.RedirectURI
 	if redirectURI != "" {
 		qs.Set("redirect_uri", redirectURI)
@@ -40,6 +40,39 @@ func NewOauthPrivateAPI(spec *loads.Document) *OauthPrivateAPI {
 		AuthorizeHandler: AuthorizeHandlerFunc(func(params AuthorizeParams) middleware.Responder {
 			return middleware.NotImplemented("operation Authorize has not yet been implemented")
 		}),
+		CreateClientHandler: CreateClientHandlerFunc(func(params CreateClientParams) middleware.Responder {
+			return middleware.NotImplemented("operation CreateClient has not yet been implemented")
+		}),
+		CreateScopeHandler: CreateScopeHandlerFunc(func(params CreateScopeParams) middleware.Responder {
+			return middleware.NotImplemented("operation CreateScope has not yet been implemented")
+		}),
+		DeleteClientHandler: DeleteClientHandlerFunc(func(params DeleteClientParams) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteClient has not yet been implemented")
+		}),
+		DeleteScopeHandler: DeleteScopeHandlerFunc(func(params DeleteScopeParams) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteScope has not yet been implemented")
+		}),
+		GetClientHandler: GetClientHandlerFunc(func(params GetClientParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetClient has not yet been implemented")
+		}),
+		GetScopeHandler: GetScopeHandlerFunc(func(params GetScopeParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetScope has not yet been implemented")
+		}),
+		ListClientsHandler: ListClientsHandlerFunc(func(params ListClientsParams) middleware.Responder {
+			return middleware.NotImplemented("operation ListClients has not yet been implemented")
+		}),
+		ListScopesHandler: ListScopesHandlerFunc(func(params ListScopesParams) middleware.Responder {
+			return middleware.NotImplemented("operation ListScopes has not yet been implemented")
+		}),
+		RotateClientSecretHandler: RotateClientSecretHandlerFunc(func(params RotateClientSecretParams) middleware.Responder {
+			return middleware.NotImplemented("operation RotateClientSecret has not yet been implemented")
+		}),
+		UpdateClientHandler: UpdateClientHandlerFunc(func(params UpdateClientParams) middleware.Responder {
+			return middleware.NotImplemented("operation UpdateClient has not yet been implemented")
+		}),
+		UpdateScopeHandler: UpdateScopeHandlerFunc(func(params UpdateScopeParams) middleware.Responder {
+			return middleware.NotImplemented("operation UpdateScope has not yet been implemented")
+		}),
 	}
 }
 
@@ -73,6 +106,28 @@ type OauthPrivateAPI struct {
 
 	// AuthorizeHandler sets the operation handler for the authorize operation
 	AuthorizeHandler AuthorizeHandler
+	// CreateClientHandler sets the operation handler for the create client operation
+	CreateClientHandler CreateClientHandler
+	// CreateScopeHandler sets the operation handler for the create scope operation
+	CreateScopeHandler CreateScopeHandler
+	// DeleteClientHandler sets the operation handler for the delete client operation
+	DeleteClientHandler DeleteClientHandler
+	// DeleteScopeHandler sets the operation handler for the delete scope operation
+	DeleteScopeHandler DeleteScopeHandler
+	// GetClientHandler sets the operation handler for the get client operation
+	GetClientHandler GetClientHandler
+	// GetScopeHandler sets the operation handler for the get scope operation
+	GetScopeHandler GetScopeHandler
+	// ListClientsHandler sets the operation handler for the list clients operation
+	ListClientsHandler ListClientsHandler
+	// ListScopesHandler sets the operation handler for the list scopes operation
+	ListScopesHandler ListScopesHandler
+	// RotateClientSecretHandler sets the operation handler for the rotate client secret operation
+	RotateClientSecretHandler RotateClientSecretHandler
+	// UpdateClientHandler sets the operation handler for the update client operation
+	UpdateClientHandler UpdateClientHandler
+	// UpdateScopeHandler sets the operation handler for the update scope operation
+	UpdateScopeHandler UpdateScopeHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -140,6 +195,50 @@ func (o *OauthPrivateAPI) Validate() error {
 		unregistered = append(unregistered, "AuthorizeHandler")
 	}
 
+	if o.CreateClientHandler == nil {
+		unregistered = append(unregistered, "CreateClientHandler")
+	}
+
+	if o.CreateScopeHandler == nil {
+		unregistered = append(unregistered, "CreateScopeHandler")
+	}
+
+	if o.DeleteClientHandler == nil {
+		unregistered = append(unregistered, "DeleteClientHandler")
+	}
+
+	if o.DeleteScopeHandler == nil {
+		unregistered = append(unregistered, "DeleteScopeHandler")
+	}
+
+	if o.GetClientHandler == nil {
+		unregistered = append(unregistered, "GetClientHandler")
+	}
+
+	if o.GetScopeHandler == nil {
+		unregistered = append(unregistered, "GetScopeHandler")
+	}
+
+	if o.ListClientsHandler == nil {
+		unregistered = append(unregistered, "ListClientsHandler")
+	}
+
+	if o.ListScopesHandler == nil {
+		unregistered = append(unregistered, "ListScopesHandler")
+	}
+
+	if o.RotateClientSecretHandler == nil {
+		unregistered = append(unregistered, "RotateClientSecretHandler")
+	}
+
+	if o.UpdateClientHandler == nil {
+		unregistered = append(unregistered, "UpdateClientHandler")
+	}
+
+	if o.UpdateScopeHandler == nil {
+		unregistered = append(unregistered, "UpdateScopeHandler")
+	}
+
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
 	}
@@ -243,6 +342,61 @@ func (o *OauthPrivateAPI) initHandlerCache() {
 	}
 	o.handlers["POST"]["/authorize"] = NewAuthorize(o.context, o.AuthorizeHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/clients"] = NewCreateClient(o.context, o.CreateClientHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/scopes"] = NewCreateScope(o.context, o.CreateScopeHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/clients/{client_id}"] = NewDeleteClient(o.context, o.DeleteClientHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/scopes/{scope}"] = NewDeleteScope(o.context, o.DeleteScopeHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clients/{client_id}"] = NewGetClient(o.context, o.GetClientHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/scopes/{scope}"] = NewGetScope(o.context, o.GetScopeHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clients"] = NewListClients(o.context, o.ListClientsHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/scopes"] = NewListScopes(o.context, o.ListScopesHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/clients/{client_id}/secret"] = NewRotateClientSecret(o.context, o.RotateClientSecretHandler)
+
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/clients/{client_id}"] = NewUpdateClient(o.context, o.UpdateClientHandler)
+
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/scopes/{scope}"] = NewUpdateScope(o.context, o.UpdateScopeHandler)
+
 }
 
 // Serve creates a http handler to serve the API over HTTP
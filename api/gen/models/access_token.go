@@ -22,6 +22,9 @@ type AccessToken struct {
 	// expires in
 	ExpiresIn int64 `json:"expires_in,omitempty"`
 
+	// ID token
+	IDToken string `json:"id_token,omitempty"`
+
 	// refresh token
 	RefreshToken string `json:"refresh_token,omitempty"`
 
@@ -12,6 +12,7 @@ Package restapi Oauth API
 
     Consumes:
     - application/json
+    - application/x-www-form-urlencoded
 
     Produces:
     - application/json
@@ -37,6 +37,100 @@ func init() {
   },
   "basePath": "/api/v1/oauth",
   "paths": {
+    "/.well-known/jwks.json": {
+      "get": {
+        "operationId": "Jwks",
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/JSONWebKeySet"
+            }
+          }
+        }
+      }
+    },
+    "/.well-known/oauth-authorization-server": {
+      "get": {
+        "operationId": "OauthAuthorizationServer",
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/ServerMetadata"
+            }
+          }
+        }
+      }
+    },
+    "/.well-known/openid-configuration": {
+      "get": {
+        "operationId": "OpenidConfiguration",
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/ServerMetadata"
+            }
+          }
+        }
+      }
+    },
+    "/introspect": {
+      "post": {
+        "security": [
+          {
+            "Basic": []
+          },
+          {}
+        ],
+        "consumes": [
+          "application/x-www-form-urlencoded"
+        ],
+        "operationId": "Introspect",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "token",
+            "in": "formData",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "token_type_hint",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_id",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_secret",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_assertion_type",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_assertion",
+            "in": "formData"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/Introspection"
+            }
+          }
+        }
+      }
+    },
     "/me": {
       "get": {
         "operationId": "Me",
@@ -44,69 +138,165 @@ func init() {
           {
             "type": "string",
             "name": "access_token",
-            "in": "query",
-            "required": true
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/UserInfo"
+            }
+          }
+        }
+      },
+      "post": {
+        "consumes": [
+          "application/x-www-form-urlencoded"
+        ],
+        "operationId": "MePost",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "access_token",
+            "in": "formData"
           }
         ],
         "responses": {
           "200": {
             "description": "ok",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/UserInfo"
             }
           }
         }
       }
     },
-    "/token": {
+    "/revoke": {
       "post": {
         "security": [
           {
             "Basic": []
+          },
+          {}
+        ],
+        "consumes": [
+          "application/x-www-form-urlencoded"
+        ],
+        "operationId": "Revoke",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "token",
+            "in": "formData",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "token_type_hint",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_id",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_secret",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_assertion_type",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_assertion",
+            "in": "formData"
           }
         ],
+        "responses": {
+          "200": {
+            "description": "ok"
+          }
+        }
+      }
+    },
+    "/token": {
+      "post": {
+        "security": [
+          {
+            "Basic": []
+          },
+          {}
+        ],
+        "consumes": [
+          "application/x-www-form-urlencoded"
+        ],
         "operationId": "Token",
         "parameters": [
           {
             "type": "string",
             "name": "grant_type",
-            "in": "query",
+            "in": "formData",
             "required": true
           },
           {
             "type": "string",
             "name": "code",
-            "in": "query"
+            "in": "formData"
           },
           {
             "type": "string",
             "name": "response_type",
-            "in": "query"
+            "in": "formData"
           },
           {
             "type": "string",
             "name": "redirect_uri",
-            "in": "query"
+            "in": "formData"
           },
           {
             "type": "string",
             "name": "state",
-            "in": "query"
+            "in": "formData"
           },
           {
             "type": "string",
             "name": "client_id",
-            "in": "query"
+            "in": "formData"
           },
           {
             "type": "string",
             "name": "refresh_token",
-            "in": "query"
+            "in": "formData"
           },
           {
             "type": "string",
             "name": "scope",
-            "in": "query"
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "code_verifier",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_secret",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_assertion_type",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_assertion",
+            "in": "formData"
           }
         ],
         "responses": {
@@ -131,6 +321,9 @@ func init() {
           "type": "integer",
           "format": "int64"
         },
+        "id_token": {
+          "type": "string"
+        },
         "refresh_token": {
           "type": "string"
         },
@@ -141,6 +334,222 @@ func init() {
           "type": "string"
         }
       }
+    },
+    "Confirmation": {
+      "type": "object",
+      "properties": {
+        "x5t#S256": {
+          "type": "string"
+        }
+      }
+    },
+    "Introspection": {
+      "type": "object",
+      "required": [
+        "active"
+      ],
+      "properties": {
+        "active": {
+          "type": "boolean"
+        },
+        "client_id": {
+          "type": "string"
+        },
+        "cnf": {
+          "$ref": "#/definitions/Confirmation"
+        },
+        "exp": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "iat": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "scope": {
+          "type": "string"
+        },
+        "sub": {
+          "type": "string"
+        },
+        "token_type": {
+          "type": "string"
+        }
+      }
+    },
+    "JSONWebKey": {
+      "type": "object",
+      "properties": {
+        "alg": {
+          "type": "string"
+        },
+        "crv": {
+          "type": "string"
+        },
+        "e": {
+          "type": "string"
+        },
+        "kid": {
+          "type": "string"
+        },
+        "kty": {
+          "type": "string"
+        },
+        "n": {
+          "type": "string"
+        },
+        "use": {
+          "type": "string"
+        },
+        "x": {
+          "type": "string"
+        },
+        "y": {
+          "type": "string"
+        }
+      }
+    },
+    "JSONWebKeySet": {
+      "type": "object",
+      "properties": {
+        "keys": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/JSONWebKey"
+          }
+        }
+      }
+    },
+    "ServerMetadata": {
+      "type": "object",
+      "properties": {
+        "authorization_endpoint": {
+          "type": "string"
+        },
+        "claims_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "code_challenge_methods_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "grant_types_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "id_token_signing_alg_values_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "introspection_endpoint": {
+          "type": "string"
+        },
+        "introspection_endpoint_auth_methods_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "introspection_endpoint_auth_signing_alg_values_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "issuer": {
+          "type": "string"
+        },
+        "jwks_uri": {
+          "type": "string"
+        },
+        "response_types_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "revocation_endpoint": {
+          "type": "string"
+        },
+        "revocation_endpoint_auth_methods_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "revocation_endpoint_auth_signing_alg_values_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "scopes_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "subject_types_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "tls_client_certificate_bound_access_tokens": {
+          "type": "boolean"
+        },
+        "token_endpoint": {
+          "type": "string"
+        },
+        "token_endpoint_auth_methods_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "token_endpoint_auth_signing_alg_values_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "userinfo_endpoint": {
+          "type": "string"
+        }
+      }
+    },
+    "UserInfo": {
+      "type": "object",
+      "required": [
+        "sub"
+      ],
+      "properties": {
+        "sub": {
+          "type": "string"
+        }
+      }
     }
   },
   "securityDefinitions": {
@@ -169,6 +578,100 @@ func init() {
   },
   "basePath": "/api/v1/oauth",
   "paths": {
+    "/.well-known/jwks.json": {
+      "get": {
+        "operationId": "Jwks",
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/JSONWebKeySet"
+            }
+          }
+        }
+      }
+    },
+    "/.well-known/oauth-authorization-server": {
+      "get": {
+        "operationId": "OauthAuthorizationServer",
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/ServerMetadata"
+            }
+          }
+        }
+      }
+    },
+    "/.well-known/openid-configuration": {
+      "get": {
+        "operationId": "OpenidConfiguration",
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/ServerMetadata"
+            }
+          }
+        }
+      }
+    },
+    "/introspect": {
+      "post": {
+        "security": [
+          {
+            "Basic": []
+          },
+          {}
+        ],
+        "consumes": [
+          "application/x-www-form-urlencoded"
+        ],
+        "operationId": "Introspect",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "token",
+            "in": "formData",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "token_type_hint",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_id",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_secret",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_assertion_type",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_assertion",
+            "in": "formData"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/Introspection"
+            }
+          }
+        }
+      }
+    },
     "/me": {
       "get": {
         "operationId": "Me",
@@ -176,69 +679,165 @@ func init() {
           {
             "type": "string",
             "name": "access_token",
-            "in": "query",
-            "required": true
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "schema": {
+              "$ref": "#/definitions/UserInfo"
+            }
+          }
+        }
+      },
+      "post": {
+        "consumes": [
+          "application/x-www-form-urlencoded"
+        ],
+        "operationId": "MePost",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "access_token",
+            "in": "formData"
           }
         ],
         "responses": {
           "200": {
             "description": "ok",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/UserInfo"
             }
           }
         }
       }
     },
-    "/token": {
+    "/revoke": {
       "post": {
         "security": [
           {
             "Basic": []
+          },
+          {}
+        ],
+        "consumes": [
+          "application/x-www-form-urlencoded"
+        ],
+        "operationId": "Revoke",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "token",
+            "in": "formData",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "token_type_hint",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_id",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_secret",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_assertion_type",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_assertion",
+            "in": "formData"
           }
         ],
+        "responses": {
+          "200": {
+            "description": "ok"
+          }
+        }
+      }
+    },
+    "/token": {
+      "post": {
+        "security": [
+          {
+            "Basic": []
+          },
+          {}
+        ],
+        "consumes": [
+          "application/x-www-form-urlencoded"
+        ],
         "operationId": "Token",
         "parameters": [
           {
             "type": "string",
             "name": "grant_type",
-            "in": "query",
+            "in": "formData",
             "required": true
           },
           {
             "type": "string",
             "name": "code",
-            "in": "query"
+            "in": "formData"
           },
           {
             "type": "string",
             "name": "response_type",
-            "in": "query"
+            "in": "formData"
           },
           {
             "type": "string",
             "name": "redirect_uri",
-            "in": "query"
+            "in": "formData"
           },
           {
             "type": "string",
             "name": "state",
-            "in": "query"
+            "in": "formData"
           },
           {
             "type": "string",
             "name": "client_id",
-            "in": "query"
+            "in": "formData"
           },
           {
             "type": "string",
             "name": "refresh_token",
-            "in": "query"
+            "in": "formData"
           },
           {
             "type": "string",
             "name": "scope",
-            "in": "query"
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "code_verifier",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_secret",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_assertion_type",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "name": "client_assertion",
+            "in": "formData"
           }
         ],
         "responses": {
@@ -263,6 +862,9 @@ func init() {
           "type": "integer",
           "format": "int64"
         },
+        "id_token": {
+          "type": "string"
+        },
         "refresh_token": {
           "type": "string"
         },
@@ -273,6 +875,222 @@ func init() {
           "type": "string"
         }
       }
+    },
+    "Confirmation": {
+      "type": "object",
+      "properties": {
+        "x5t#S256": {
+          "type": "string"
+        }
+      }
+    },
+    "Introspection": {
+      "type": "object",
+      "required": [
+        "active"
+      ],
+      "properties": {
+        "active": {
+          "type": "boolean"
+        },
+        "client_id": {
+          "type": "string"
+        },
+        "cnf": {
+          "$ref": "#/definitions/Confirmation"
+        },
+        "exp": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "iat": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "scope": {
+          "type": "string"
+        },
+        "sub": {
+          "type": "string"
+        },
+        "token_type": {
+          "type": "string"
+        }
+      }
+    },
+    "JSONWebKey": {
+      "type": "object",
+      "properties": {
+        "alg": {
+          "type": "string"
+        },
+        "crv": {
+          "type": "string"
+        },
+        "e": {
+          "type": "string"
+        },
+        "kid": {
+          "type": "string"
+        },
+        "kty": {
+          "type": "string"
+        },
+        "n": {
+          "type": "string"
+        },
+        "use": {
+          "type": "string"
+        },
+        "x": {
+          "type": "string"
+        },
+        "y": {
+          "type": "string"
+        }
+      }
+    },
+    "JSONWebKeySet": {
+      "type": "object",
+      "properties": {
+        "keys": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/JSONWebKey"
+          }
+        }
+      }
+    },
+    "ServerMetadata": {
+      "type": "object",
+      "properties": {
+        "authorization_endpoint": {
+          "type": "string"
+        },
+        "claims_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "code_challenge_methods_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "grant_types_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "id_token_signing_alg_values_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "introspection_endpoint": {
+          "type": "string"
+        },
+        "introspection_endpoint_auth_methods_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "introspection_endpoint_auth_signing_alg_values_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "issuer": {
+          "type": "string"
+        },
+        "jwks_uri": {
+          "type": "string"
+        },
+        "response_types_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "revocation_endpoint": {
+          "type": "string"
+        },
+        "revocation_endpoint_auth_methods_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "revocation_endpoint_auth_signing_alg_values_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "scopes_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "subject_types_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "tls_client_certificate_bound_access_tokens": {
+          "type": "boolean"
+        },
+        "token_endpoint": {
+          "type": "string"
+        },
+        "token_endpoint_auth_methods_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "token_endpoint_auth_signing_alg_values_supported": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "userinfo_endpoint": {
+          "type": "string"
+        }
+      }
+    },
+    "UserInfo": {
+      "type": "object",
+      "required": [
+        "sub"
+      ],
+      "properties": {
+        "sub": {
+          "type": "string"
+        }
+      }
     }
   },
   "securityDefinitions": {
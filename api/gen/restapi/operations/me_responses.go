@@ -9,6 +9,8 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+
+	models "github.com/NeuronOauth/oauth/api/gen/models"
 )
 
 // MeOKCode is the HTTP code returned for type MeOK
@@ -23,7 +25,7 @@ type MeOK struct {
 	/*
 	  In: Body
 	*/
-	Payload string `json:"body,omitempty"`
+	Payload *models.UserInfo `json:"body,omitempty"`
 }
 
 // NewMeOK creates MeOK with default headers values
@@ -33,13 +35,13 @@ func NewMeOK() *MeOK {
 }
 
 // WithPayload adds the payload to the me o k response
-func (o *MeOK) WithPayload(payload string) *MeOK {
+func (o *MeOK) WithPayload(payload *models.UserInfo) *MeOK {
 	o.Payload = payload
 	return o
 }
 
 // SetPayload sets the payload to the me o k response
-func (o *MeOK) SetPayload(payload string) {
+func (o *MeOK) SetPayload(payload *models.UserInfo) {
 	o.Payload = payload
 }
 
@@ -47,9 +49,10 @@ func (o *MeOK) SetPayload(payload string) {
 func (o *MeOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
 	rw.WriteHeader(200)
-	payload := o.Payload
-	if err := producer.Produce(rw, payload); err != nil {
-		panic(err) // let the recovery middleware deal with this
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
 	}
-
 }
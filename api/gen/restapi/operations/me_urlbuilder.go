@@ -13,7 +13,7 @@ import (
 
 // MeURL generates an URL for the me operation
 type MeURL struct {
-	AccessToken string
+	AccessToken *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -49,7 +49,10 @@ func (o *MeURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
-	accessToken := o.AccessToken
+	var accessToken string
+	if o.AccessToken != nil {
+		accessToken = *o.AccessToken
+	}
 	if accessToken != "" {
 		qs.Set("access_token", accessToken)
 	}
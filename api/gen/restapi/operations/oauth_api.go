@@ -37,10 +37,29 @@ func NewOauthAPI(spec *loads.Document) *OauthAPI {
 		APIKeyAuthenticator: security.APIKeyAuth,
 		BearerAuthenticator: security.BearerAuth,
 		JSONConsumer:        runtime.JSONConsumer(),
+		UrlformConsumer:     runtime.DiscardConsumer,
 		JSONProducer:        runtime.JSONProducer(),
+		IntrospectHandler: IntrospectHandlerFunc(func(params IntrospectParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation Introspect has not yet been implemented")
+		}),
+		JwksHandler: JwksHandlerFunc(func(params JwksParams) middleware.Responder {
+			return middleware.NotImplemented("operation Jwks has not yet been implemented")
+		}),
 		MeHandler: MeHandlerFunc(func(params MeParams) middleware.Responder {
 			return middleware.NotImplemented("operation Me has not yet been implemented")
 		}),
+		MePostHandler: MePostHandlerFunc(func(params MePostParams) middleware.Responder {
+			return middleware.NotImplemented("operation MePost has not yet been implemented")
+		}),
+		OauthAuthorizationServerHandler: OauthAuthorizationServerHandlerFunc(func(params OauthAuthorizationServerParams) middleware.Responder {
+			return middleware.NotImplemented("operation OauthAuthorizationServer has not yet been implemented")
+		}),
+		OpenidConfigurationHandler: OpenidConfigurationHandlerFunc(func(params OpenidConfigurationParams) middleware.Responder {
+			return middleware.NotImplemented("operation OpenidConfiguration has not yet been implemented")
+		}),
+		RevokeHandler: RevokeHandlerFunc(func(params RevokeParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation Revoke has not yet been implemented")
+		}),
 		TokenHandler: TokenHandlerFunc(func(params TokenParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation Token has not yet been implemented")
 		}),
@@ -80,6 +99,9 @@ type OauthAPI struct {
 	// JSONConsumer registers a consumer for a "application/json" mime type
 	JSONConsumer runtime.Consumer
 
+	// UrlformConsumer registers a consumer for a "application/x-www-form-urlencoded" mime type
+	UrlformConsumer runtime.Consumer
+
 	// JSONProducer registers a producer for a "application/json" mime type
 	JSONProducer runtime.Producer
 
@@ -90,8 +112,20 @@ type OauthAPI struct {
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
+	// IntrospectHandler sets the operation handler for the introspect operation
+	IntrospectHandler IntrospectHandler
+	// JwksHandler sets the operation handler for the jwks operation
+	JwksHandler JwksHandler
 	// MeHandler sets the operation handler for the me operation
 	MeHandler MeHandler
+	// MePostHandler sets the operation handler for the me post operation
+	MePostHandler MePostHandler
+	// OauthAuthorizationServerHandler sets the operation handler for the oauth authorization server operation
+	OauthAuthorizationServerHandler OauthAuthorizationServerHandler
+	// OpenidConfigurationHandler sets the operation handler for the openid configuration operation
+	OpenidConfigurationHandler OpenidConfigurationHandler
+	// RevokeHandler sets the operation handler for the revoke operation
+	RevokeHandler RevokeHandler
 	// TokenHandler sets the operation handler for the token operation
 	TokenHandler TokenHandler
 
@@ -153,6 +187,10 @@ func (o *OauthAPI) Validate() error {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
 
+	if o.UrlformConsumer == nil {
+		unregistered = append(unregistered, "UrlformConsumer")
+	}
+
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
@@ -161,10 +199,34 @@ func (o *OauthAPI) Validate() error {
 		unregistered = append(unregistered, "BasicAuth")
 	}
 
+	if o.IntrospectHandler == nil {
+		unregistered = append(unregistered, "IntrospectHandler")
+	}
+
+	if o.JwksHandler == nil {
+		unregistered = append(unregistered, "JwksHandler")
+	}
+
 	if o.MeHandler == nil {
 		unregistered = append(unregistered, "MeHandler")
 	}
 
+	if o.MePostHandler == nil {
+		unregistered = append(unregistered, "MePostHandler")
+	}
+
+	if o.OauthAuthorizationServerHandler == nil {
+		unregistered = append(unregistered, "OauthAuthorizationServerHandler")
+	}
+
+	if o.OpenidConfigurationHandler == nil {
+		unregistered = append(unregistered, "OpenidConfigurationHandler")
+	}
+
+	if o.RevokeHandler == nil {
+		unregistered = append(unregistered, "RevokeHandler")
+	}
+
 	if o.TokenHandler == nil {
 		unregistered = append(unregistered, "TokenHandler")
 	}
@@ -215,6 +277,9 @@ func (o *OauthAPI) ConsumersFor(mediaTypes []string) map[string]runtime.Consumer
 		case "application/json":
 			result["application/json"] = o.JSONConsumer
 
+		case "application/x-www-form-urlencoded":
+			result["application/x-www-form-urlencoded"] = o.UrlformConsumer
+
 		}
 
 		if c, ok := o.customConsumers[mt]; ok {
@@ -277,11 +342,41 @@ func (o *OauthAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/introspect"] = NewIntrospect(o.context, o.IntrospectHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/.well-known/jwks.json"] = NewJwks(o.context, o.JwksHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/me"] = NewMe(o.context, o.MeHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/me"] = NewMePost(o.context, o.MePostHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/.well-known/oauth-authorization-server"] = NewOauthAuthorizationServer(o.context, o.OauthAuthorizationServerHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/.well-known/openid-configuration"] = NewOpenidConfiguration(o.context, o.OpenidConfigurationHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/revoke"] = NewRevoke(o.context, o.RevokeHandler)
+
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -33,36 +33,52 @@ type TokenParams struct {
 	HTTPRequest *http.Request `json:"-"`
 
 	/*
-	  In: query
+	  In: formData
+	*/
+	ClientAssertion *string
+	/*
+	  In: formData
+	*/
+	ClientAssertionType *string
+	/*
+	  In: formData
 	*/
 	ClientID *string
 	/*
-	  In: query
+	  In: formData
+	*/
+	ClientSecret *string
+	/*
+	  In: formData
 	*/
 	Code *string
+	/*
+	  In: formData
+	*/
+	CodeVerifier *string
 	/*
 	  Required: true
-	  In: query
+	  In: formData
 	*/
 	GrantType string
 	/*
-	  In: query
+	  In: formData
 	*/
 	RedirectURI *string
 	/*
-	  In: query
+	  In: formData
 	*/
 	RefreshToken *string
 	/*
-	  In: query
+	  In: formData
 	*/
 	ResponseType *string
 	/*
-	  In: query
+	  In: formData
 	*/
 	Scope *string
 	/*
-	  In: query
+	  In: formData
 	*/
 	State *string
 }
@@ -76,45 +92,72 @@ func (o *TokenParams) BindRequest(r *http.Request, route *middleware.MatchedRout
 
 	o.HTTPRequest = r
 
-	qs := runtime.Values(r.URL.Query())
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		if err != http.ErrNotMultipart {
+			return err
+		} else if err := r.ParseForm(); err != nil {
+			return err
+		}
+	}
+	fds := runtime.Values(r.Form)
+
+	fdClientAssertion, fdhkClientAssertion, _ := fds.GetOK("client_assertion")
+	if err := o.bindClientAssertion(fdClientAssertion, fdhkClientAssertion, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	fdClientAssertionType, fdhkClientAssertionType, _ := fds.GetOK("client_assertion_type")
+	if err := o.bindClientAssertionType(fdClientAssertionType, fdhkClientAssertionType, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	fdClientID, fdhkClientID, _ := fds.GetOK("client_id")
+	if err := o.bindClientID(fdClientID, fdhkClientID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	fdClientSecret, fdhkClientSecret, _ := fds.GetOK("client_secret")
+	if err := o.bindClientSecret(fdClientSecret, fdhkClientSecret, route.Formats); err != nil {
+		res = append(res, err)
+	}
 
-	qClientID, qhkClientID, _ := qs.GetOK("client_id")
-	if err := o.bindClientID(qClientID, qhkClientID, route.Formats); err != nil {
+	fdCode, fdhkCode, _ := fds.GetOK("code")
+	if err := o.bindCode(fdCode, fdhkCode, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
-	qCode, qhkCode, _ := qs.GetOK("code")
-	if err := o.bindCode(qCode, qhkCode, route.Formats); err != nil {
+	fdCodeVerifier, fdhkCodeVerifier, _ := fds.GetOK("code_verifier")
+	if err := o.bindCodeVerifier(fdCodeVerifier, fdhkCodeVerifier, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
-	qGrantType, qhkGrantType, _ := qs.GetOK("grant_type")
-	if err := o.bindGrantType(qGrantType, qhkGrantType, route.Formats); err != nil {
+	fdGrantType, fdhkGrantType, _ := fds.GetOK("grant_type")
+	if err := o.bindGrantType(fdGrantType, fdhkGrantType, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
-	qRedirectURI, qhkRedirectURI, _ := qs.GetOK("redirect_uri")
-	if err := o.bindRedirectURI(qRedirectURI, qhkRedirectURI, route.Formats); err != nil {
+	fdRedirectURI, fdhkRedirectURI, _ := fds.GetOK("redirect_uri")
+	if err := o.bindRedirectURI(fdRedirectURI, fdhkRedirectURI, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
-	qRefreshToken, qhkRefreshToken, _ := qs.GetOK("refresh_token")
-	if err := o.bindRefreshToken(qRefreshToken, qhkRefreshToken, route.Formats); err != nil {
+	fdRefreshToken, fdhkRefreshToken, _ := fds.GetOK("refresh_token")
+	if err := o.bindRefreshToken(fdRefreshToken, fdhkRefreshToken, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
-	qResponseType, qhkResponseType, _ := qs.GetOK("response_type")
-	if err := o.bindResponseType(qResponseType, qhkResponseType, route.Formats); err != nil {
+	fdResponseType, fdhkResponseType, _ := fds.GetOK("response_type")
+	if err := o.bindResponseType(fdResponseType, fdhkResponseType, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
-	qScope, qhkScope, _ := qs.GetOK("scope")
-	if err := o.bindScope(qScope, qhkScope, route.Formats); err != nil {
+	fdScope, fdhkScope, _ := fds.GetOK("scope")
+	if err := o.bindScope(fdScope, fdhkScope, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
-	qState, qhkState, _ := qs.GetOK("state")
-	if err := o.bindState(qState, qhkState, route.Formats); err != nil {
+	fdState, fdhkState, _ := fds.GetOK("state")
+	if err := o.bindState(fdState, fdhkState, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -124,6 +167,40 @@ func (o *TokenParams) BindRequest(r *http.Request, route *middleware.MatchedRout
 	return nil
 }
 
+func (o *TokenParams) bindClientAssertion(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.ClientAssertion = &raw
+
+	return nil
+}
+
+func (o *TokenParams) bindClientAssertionType(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.ClientAssertionType = &raw
+
+	return nil
+}
+
 func (o *TokenParams) bindClientID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
 	if len(rawData) > 0 {
@@ -141,6 +218,23 @@ func (o *TokenParams) bindClientID(rawData []string, hasKey bool, formats strfmt
 	return nil
 }
 
+func (o *TokenParams) bindClientSecret(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.ClientSecret = &raw
+
+	return nil
+}
+
 func (o *TokenParams) bindCode(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
 	if len(rawData) > 0 {
@@ -158,9 +252,26 @@ func (o *TokenParams) bindCode(rawData []string, hasKey bool, formats strfmt.Reg
 	return nil
 }
 
+func (o *TokenParams) bindCodeVerifier(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.CodeVerifier = &raw
+
+	return nil
+}
+
 func (o *TokenParams) bindGrantType(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	if !hasKey {
-		return errors.Required("grant_type", "query")
+		return errors.Required("grant_type", "formData")
 	}
 	var raw string
 	if len(rawData) > 0 {
@@ -169,7 +280,7 @@ func (o *TokenParams) bindGrantType(rawData []string, hasKey bool, formats strfm
 
 	// Required: true
 	// AllowEmptyValue: false
-	if err := validate.RequiredString("grant_type", "query", raw); err != nil {
+	if err := validate.RequiredString("grant_type", "formData", raw); err != nil {
 		return err
 	}
 
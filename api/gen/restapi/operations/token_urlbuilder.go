@@ -13,18 +13,7 @@ import (
 
 // TokenURL generates an URL for the token operation
 type TokenURL struct {
-	ClientID     *string
-	Code         *string
-	GrantType    string
-	RedirectURI  *string
-	RefreshToken *string
-	ResponseType *string
-	Scope        *string
-	State        *string
-
 	_basePath string
-	// avoid unkeyed usage
-	_ struct{}
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
@@ -54,71 +43,6 @@ func (o *TokenURL) Build() (*url.URL, error) {
 	}
 	result.Path = golangswaggerpaths.Join(_basePath, _path)
 
-	qs := make(url.Values)
-
-	var clientID string
-	if o.ClientID != nil {
-		clientID = *o.ClientID
-	}
-	if clientID != "" {
-		qs.Set("client_id", clientID)
-	}
-
-	var code string
-	if o.Code != nil {
-		code = *o.Code
-	}
-	if code != "" {
-		qs.Set("code", code)
-	}
-
-	grantType := o.GrantType
-	if grantType != "" {
-		qs.Set("grant_type", grantType)
-	}
-
-	var redirectURI string
-	if o.RedirectURI != nil {
-		redirectURI = *o.RedirectURI
-	}
-	if redirectURI != "" {
-		qs.Set("redirect_uri", redirectURI)
-	}
-
-	var refreshToken string
-	if o.RefreshToken != nil {
-		refreshToken = *o.RefreshToken
-	}
-	if refreshToken != "" {
-		qs.Set("refresh_token", refreshToken)
-	}
-
-	var responseType string
-	if o.ResponseType != nil {
-		responseType = *o.ResponseType
-	}
-	if responseType != "" {
-		qs.Set("response_type", responseType)
-	}
-
-	var scope string
-	if o.Scope != nil {
-		scope = *o.Scope
-	}
-	if scope != "" {
-		qs.Set("scope", scope)
-	}
-
-	var state string
-	if o.State != nil {
-		state = *o.State
-	}
-	if state != "" {
-		qs.Set("state", state)
-	}
-
-	result.RawQuery = qs.Encode()
-
 	return &result, nil
 }
 
@@ -16,6 +16,97 @@ func fromTokenResponse(p *models.AccessToken) (r *api.AccessToken) {
 	r.ExpiresIn = p.ExpiresIn
 	r.RefreshToken = p.RefreshToken
 	r.Scope = p.Scope
+	r.IDToken = p.IdToken
+
+	return r
+}
+
+func fromIntrospection(p *models.TokenIntrospection) (r *api.Introspection) {
+	if p == nil {
+		return nil
+	}
+
+	r = &api.Introspection{}
+	r.Active = &p.Active
+	if !p.Active {
+		return r
+	}
+
+	r.Scope = p.Scope
+	r.ClientID = p.ClientId
+	r.Sub = p.AccountId
+	r.Exp = p.ExpiresAt
+	r.Iat = p.IssuedAt
+	r.TokenType = p.TokenType
+	if p.CertThumbprint != "" {
+		r.Cnf = &api.Confirmation{X5tS256: p.CertThumbprint}
+	}
+
+	return r
+}
+
+func fromJSONWebKeySet(p *models.JSONWebKeySet) (r *api.JSONWebKeySet) {
+	if p == nil {
+		return nil
+	}
+
+	r = &api.JSONWebKeySet{}
+	r.Keys = make([]*api.JSONWebKey, 0, len(p.Keys))
+	for _, v := range p.Keys {
+		r.Keys = append(r.Keys, &api.JSONWebKey{
+			Kty: v.Kty,
+			Use: v.Use,
+			Kid: v.Kid,
+			Alg: v.Alg,
+			N:   v.N,
+			E:   v.E,
+			Crv: v.Crv,
+			X:   v.X,
+			Y:   v.Y,
+		})
+	}
+
+	return r
+}
+
+func fromUserInfo(p *models.UserInfo) (r *api.UserInfo) {
+	if p == nil {
+		return nil
+	}
+
+	r = &api.UserInfo{}
+	r.Sub = &p.Subject
+
+	return r
+}
+
+func fromServerMetadata(p *models.ServerMetadata) (r *api.ServerMetadata) {
+	if p == nil {
+		return nil
+	}
+
+	r = &api.ServerMetadata{}
+	r.Issuer = p.Issuer
+	r.AuthorizationEndpoint = p.AuthorizationEndpoint
+	r.TokenEndpoint = p.TokenEndpoint
+	r.JwksURI = p.JwksUri
+	r.UserinfoEndpoint = p.UserinfoEndpoint
+	r.RevocationEndpoint = p.RevocationEndpoint
+	r.IntrospectionEndpoint = p.IntrospectionEndpoint
+	r.ScopesSupported = p.ScopesSupported
+	r.ResponseTypesSupported = p.ResponseTypesSupported
+	r.GrantTypesSupported = p.GrantTypesSupported
+	r.TokenEndpointAuthMethodsSupported = p.TokenEndpointAuthMethodsSupported
+	r.RevocationEndpointAuthMethodsSupported = p.RevocationEndpointAuthMethodsSupported
+	r.IntrospectionEndpointAuthMethodsSupported = p.IntrospectionEndpointAuthMethodsSupported
+	r.TokenEndpointAuthSigningAlgValuesSupported = p.TokenEndpointAuthSigningAlgValuesSupported
+	r.RevocationEndpointAuthSigningAlgValuesSupported = p.RevocationEndpointAuthSigningAlgValuesSupported
+	r.IntrospectionEndpointAuthSigningAlgValuesSupported = p.IntrospectionEndpointAuthSigningAlgValuesSupported
+	r.CodeChallengeMethodsSupported = p.CodeChallengeMethodsSupported
+	r.TLSClientCertificateBoundAccessTokens = p.TlsClientCertificateBoundAccessTokens
+	r.SubjectTypesSupported = p.SubjectTypesSupported
+	r.IDTokenSigningAlgValuesSupported = p.IdTokenSigningAlgValuesSupported
+	r.ClaimsSupported = p.ClaimsSupported
 
 	return r
 }
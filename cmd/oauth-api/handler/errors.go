@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/NeuronFramework/restful"
+	"github.com/NeuronOauth/oauth/services"
+	openapierrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+// oauthErrorBody is the error response of RFC 6749 §5.2.
+type oauthErrorBody struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// noStore keeps token endpoint responses out of caches (RFC 6749 §5.1).
+func noStore(rw http.ResponseWriter) {
+	rw.Header().Set("Cache-Control", "no-store")
+	rw.Header().Set("Pragma", "no-cache")
+}
+
+func withNoStore(r middleware.Responder) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
+		noStore(rw)
+		r.WriteResponse(rw, producer)
+	})
+}
+
+func writeOauthError(rw http.ResponseWriter, e *services.OauthError) {
+	noStore(rw)
+	if e.Code == services.ErrorInvalidClient {
+		rw.Header().Set("WWW-Authenticate", `Basic realm="oauth"`)
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(e.Status())
+	json.NewEncoder(rw).Encode(&oauthErrorBody{Error: e.Code, ErrorDescription: e.Description})
+}
+
+// writeBearerError writes an RFC 6750 §3 error. A request that carried no token
+// at all only gets the challenge.
+func writeBearerError(rw http.ResponseWriter, e *services.OauthError) {
+	if e == nil {
+		rw.Header().Set("WWW-Authenticate", `Bearer realm="oauth"`)
+		rw.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	rw.Header().Set("WWW-Authenticate",
+		fmt.Sprintf(`Bearer realm="oauth", error="%s", error_description="%s"`, e.Code, e.Description))
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(e.Status())
+	json.NewEncoder(rw).Encode(&oauthErrorBody{Error: e.Code, ErrorDescription: e.Description})
+}
+
+// bearerError is oauthError for requests authenticated with an access token, a
+// nil err answers a request without token.
+func (h *OauthHandler) bearerError(err error) middleware.Responder {
+	var e *services.OauthError
+	if err != nil {
+		var ok bool
+		e, ok = err.(*services.OauthError)
+		if !ok {
+			h.logger.Error("oauth", zap.Error(err))
+			e = services.NewOauthError(services.ErrorServerError, "internal server error")
+		}
+	}
+
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
+		writeBearerError(rw, e)
+	})
+}
+
+// oauthError turns a service error into an RFC 6749 error response. Anything that
+// is not an OauthError, a failing database for instance, is logged and reported
+// as server_error.
+func (h *OauthHandler) oauthError(err error) middleware.Responder {
+	e, ok := err.(*services.OauthError)
+	if !ok {
+		h.logger.Error("oauth", zap.Error(err))
+		e = services.NewOauthError(services.ErrorServerError, "internal server error")
+	}
+
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
+		writeOauthError(rw, e)
+	})
+}
+
+// ServeError renders the errors raised before a handler runs, failed client
+// authentication and invalid parameters, in the RFC 6749 format.
+func (h *OauthHandler) ServeError(rw http.ResponseWriter, r *http.Request, err error) {
+	switch e := err.(type) {
+	case *services.OauthError:
+		writeOauthError(rw, e)
+	case openapierrors.Error:
+		switch e.Code() {
+		case http.StatusUnauthorized:
+			writeOauthError(rw, services.NewOauthError(services.ErrorInvalidClient, "client authentication failed"))
+		case http.StatusBadRequest, http.StatusUnprocessableEntity:
+			writeOauthError(rw, services.NewOauthError(services.ErrorInvalidRequest, e.Error()))
+		default:
+			restful.ServeError(rw, r, err)
+		}
+	default:
+		h.logger.Error("oauth", zap.Error(err))
+		writeOauthError(rw, services.NewOauthError(services.ErrorServerError, "internal server error"))
+	}
+}
@@ -9,80 +9,243 @@ import (
 	"github.com/NeuronOauth/oauth/services"
 	"github.com/go-openapi/runtime/middleware"
 	"go.uber.org/zap"
+	"net/http"
 )
 
 type OauthHandler struct {
-	logger  *zap.Logger
-	service *services.OauthService
+	logger                  *zap.Logger
+	service                 *services.OauthService
+	allowAccessTokenQuery   bool
+	clientCertificateHeader string
 }
 
 func NewOauthHandler() (h *OauthHandler, err error) {
 	h = &OauthHandler{}
 	h.logger = log.TypedLogger(h)
-	h.service, err = services.NewOauthService(&services.OauthServiceOptions{})
+
+	options, err := services.NewOauthServiceOptionsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	h.service, err = services.NewOauthService(options)
 	if err != nil {
 		return nil, err
 	}
 
+	h.allowAccessTokenQuery = options.AllowAccessTokenQuery
+	h.clientCertificateHeader = options.ClientCertificateHeader
+
 	return h, nil
 }
 
 func (h *OauthHandler) BasicAuth(clientId string, password string) (interface{}, error) {
-	c, err := h.service.ClientLogin(&restful.Context{}, clientId, password)
-	return c, err
+	c, err := h.service.AuthenticateClient(&restful.Context{}, services.ClientAuthMethodSecretBasic, clientId, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
 }
 
-func (h *OauthHandler) Token(p operations.TokenParams, oauthClient interface{}) middleware.Responder {
-	if oauthClient == nil {
-		return errors.Unauthorized("client认证失败")
+func (h *OauthHandler) Token(p operations.TokenParams, principal interface{}) middleware.Responder {
+	err := checkQueryCredentials(p.HTTPRequest)
+	if err != nil {
+		return h.oauthError(err)
 	}
 
-	if p.GrantType == "authorization_code" {
+	client, err := h.authenticateClient(p.HTTPRequest, principal, &clientCredentials{
+		ClientId:            p.ClientID,
+		ClientSecret:        p.ClientSecret,
+		ClientAssertionType: p.ClientAssertionType,
+		ClientAssertion:     p.ClientAssertion,
+	})
+	if err != nil {
+		return h.oauthError(err)
+	}
+
+	if p.GrantType == services.GrantTypeAuthorizationCode {
 		if p.Code == nil {
-			return errors.InvalidParam("Code不能为空")
+			return h.oauthError(services.NewOauthError(services.ErrorInvalidRequest, "code is required"))
 		}
 
 		if p.RedirectURI == nil {
-			return errors.InvalidParam("RedirectURI不能为空")
+			return h.oauthError(services.NewOauthError(services.ErrorInvalidRequest, "redirect_uri is required"))
+		}
+
+		// RFC 6749 §4.1.3 only requires client_id from clients that do not authenticate,
+		// a private_key_jwt assertion names the client in its sub
+		clientId := client.ClientId
+		if p.ClientID != nil {
+			clientId = *p.ClientID
 		}
 
-		if p.ClientID == nil {
-			return errors.InvalidParam("ClientID不能为空")
+		codeVerifier := ""
+		if p.CodeVerifier != nil {
+			codeVerifier = *p.CodeVerifier
 		}
 
 		result, err := h.service.AuthorizeCodeGrant(restful.NewContext(p.HTTPRequest),
-			*p.Code, *p.RedirectURI, *p.ClientID, oauthClient.(*models.OauthClient))
+			*p.Code, *p.RedirectURI, clientId, codeVerifier, client)
 		if err != nil {
-			return errors.Wrap(err)
+			return h.oauthError(err)
 		}
 
-		return operations.NewTokenOK().WithPayload(fromTokenResponse(result))
-	} else if p.GrantType == "refresh_token" {
+		return withNoStore(operations.NewTokenOK().WithPayload(fromTokenResponse(result)))
+	} else if p.GrantType == services.GrantTypeRefreshToken {
 		if p.RefreshToken == nil {
-			return errors.InvalidParam("RefreshToken不能为空")
+			return h.oauthError(services.NewOauthError(services.ErrorInvalidRequest, "refresh_token is required"))
 		}
 
-		if p.Scope == nil {
-			return errors.InvalidParam("Scope不能为空")
+		scope := ""
+		if p.Scope != nil {
+			scope = *p.Scope
 		}
 
 		result, err := h.service.RefreshTokenGrant(restful.NewContext(p.HTTPRequest),
-			*p.RefreshToken, *p.Scope, oauthClient.(*models.OauthClient))
+			*p.RefreshToken, scope, client)
+		if err != nil {
+			return h.oauthError(err)
+		}
+
+		return withNoStore(operations.NewTokenOK().WithPayload(fromTokenResponse(result)))
+	} else if p.GrantType == services.GrantTypeClientCredentials {
+		scope := ""
+		if p.Scope != nil {
+			scope = *p.Scope
+		}
+
+		result, err := h.service.ClientCredentialsGrant(restful.NewContext(p.HTTPRequest),
+			scope, client)
 		if err != nil {
-			return errors.Wrap(err)
+			return h.oauthError(err)
 		}
 
-		return operations.NewTokenOK().WithPayload(fromTokenResponse(result))
+		return withNoStore(operations.NewTokenOK().WithPayload(fromTokenResponse(result)))
 	} else {
-		return errors.InvalidParam("GrantType未知的类型")
+		return h.oauthError(services.NewOauthError(services.ErrorUnsupportedGrantType, "unsupported grant_type"))
+	}
+}
+
+func (h *OauthHandler) Revoke(p operations.RevokeParams, principal interface{}) middleware.Responder {
+	err := checkQueryCredentials(p.HTTPRequest)
+	if err != nil {
+		return h.oauthError(err)
+	}
+
+	client, err := h.authenticateClient(p.HTTPRequest, principal, &clientCredentials{
+		ClientId:            p.ClientID,
+		ClientSecret:        p.ClientSecret,
+		ClientAssertionType: p.ClientAssertionType,
+		ClientAssertion:     p.ClientAssertion,
+	})
+	if err != nil {
+		return h.oauthError(err)
+	}
+
+	tokenTypeHint := ""
+	if p.TokenTypeHint != nil {
+		tokenTypeHint = *p.TokenTypeHint
 	}
+
+	err = h.service.Revoke(restful.NewContext(p.HTTPRequest), p.Token, tokenTypeHint, client)
+	if err != nil {
+		return h.oauthError(err)
+	}
+
+	return operations.NewRevokeOK()
+}
+
+func (h *OauthHandler) Introspect(p operations.IntrospectParams, principal interface{}) middleware.Responder {
+	err := checkQueryCredentials(p.HTTPRequest)
+	if err != nil {
+		return h.oauthError(err)
+	}
+
+	client, err := h.authenticateClient(p.HTTPRequest, principal, &clientCredentials{
+		ClientId:            p.ClientID,
+		ClientSecret:        p.ClientSecret,
+		ClientAssertionType: p.ClientAssertionType,
+		ClientAssertion:     p.ClientAssertion,
+	})
+	if err != nil {
+		return h.oauthError(err)
+	}
+
+	tokenTypeHint := ""
+	if p.TokenTypeHint != nil {
+		tokenTypeHint = *p.TokenTypeHint
+	}
+
+	cert, err := h.clientCertificate(p.HTTPRequest)
+	if err != nil {
+		return h.oauthError(err)
+	}
+
+	result, err := h.service.Introspect(restful.NewContext(p.HTTPRequest), p.Token, tokenTypeHint, client, cert)
+	if err != nil {
+		return h.oauthError(err)
+	}
+
+	return operations.NewIntrospectOK().WithPayload(fromIntrospection(result))
 }
 
 func (h *OauthHandler) Me(p operations.MeParams) middleware.Responder {
-	openId, err := h.service.Me(restful.NewContext(p.HTTPRequest), p.AccessToken)
+	userInfo, err := h.me(p.HTTPRequest)
+	if err != nil || userInfo == nil {
+		return h.bearerError(err)
+	}
+
+	return operations.NewMeOK().WithPayload(fromUserInfo(userInfo))
+}
+
+func (h *OauthHandler) MePost(p operations.MePostParams) middleware.Responder {
+	userInfo, err := h.me(p.HTTPRequest)
+	if err != nil || userInfo == nil {
+		return h.bearerError(err)
+	}
+
+	return operations.NewMePostOK().WithPayload(fromUserInfo(userInfo))
+}
+
+// me returns nil without error when the request carried no access token.
+func (h *OauthHandler) me(r *http.Request) (userInfo *models.UserInfo, err error) {
+	accessToken, err := bearerToken(r, h.allowAccessTokenQuery)
+	if err != nil || accessToken == "" {
+		return nil, err
+	}
+
+	cert, err := h.clientCertificate(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.service.Me(restful.NewContext(r), accessToken, cert)
+}
+
+func (h *OauthHandler) Jwks(p operations.JwksParams) middleware.Responder {
+	result, err := h.service.Jwks(restful.NewContext(p.HTTPRequest))
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	return operations.NewJwksOK().WithPayload(fromJSONWebKeySet(result))
+}
+
+func (h *OauthHandler) OpenidConfiguration(p operations.OpenidConfigurationParams) middleware.Responder {
+	result, err := h.service.OpenidConfiguration(restful.NewContext(p.HTTPRequest))
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	return operations.NewOpenidConfigurationOK().WithPayload(fromServerMetadata(result))
+}
+
+func (h *OauthHandler) OauthAuthorizationServer(p operations.OauthAuthorizationServerParams) middleware.Responder {
+	result, err := h.service.ServerMetadata(restful.NewContext(p.HTTPRequest))
 	if err != nil {
 		return errors.Wrap(err)
 	}
 
-	return operations.NewMeOK().WithPayload(openId)
+	return operations.NewOauthAuthorizationServerOK().WithPayload(fromServerMetadata(result))
 }
@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"github.com/NeuronFramework/restful"
+	"github.com/NeuronOauth/oauth/models"
+	"github.com/NeuronOauth/oauth/services"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// bodyOnlyParams carry credentials and must not be sent in the query string,
+// which ends up in access logs. ParseForm merges the query into the form the
+// parameters are bound from, so without this check they would be accepted.
+var bodyOnlyParams = []string{
+	"client_assertion",
+	"client_secret",
+	"code",
+	"code_verifier",
+	"refresh_token",
+	"token",
+}
+
+func checkQueryCredentials(r *http.Request) error {
+	q := r.URL.Query()
+	for _, v := range bodyOnlyParams {
+		if _, ok := q[v]; ok {
+			return services.NewOauthError(services.ErrorInvalidRequest, v+" must be sent in the request body")
+		}
+	}
+
+	return nil
+}
+
+// bearerToken extracts the access token of an RFC 6750 request from the
+// Authorization header, the form body or, when allowed, the query string. A
+// request must use exactly one of them (§2). An empty token without error means
+// the request carried none.
+func bearerToken(r *http.Request, allowQuery bool) (token string, err error) {
+	methods := 0
+
+	if v := r.Header.Get("Authorization"); len(v) > 7 && strings.EqualFold(v[:7], "Bearer ") {
+		token = strings.TrimSpace(v[7:])
+		methods++
+	}
+
+	// PostForm only holds the body, unlike Form it is not merged with the query
+	if v, ok := r.PostForm["access_token"]; ok && len(v) > 0 {
+		token = v[0]
+		methods++
+	}
+
+	if v, ok := r.URL.Query()["access_token"]; ok && len(v) > 0 {
+		if !allowQuery {
+			return "", services.NewOauthError(services.ErrorInvalidRequest, "access_token must not be sent in the query string")
+		}
+
+		token = v[0]
+		methods++
+	}
+
+	if methods > 1 {
+		return "", services.NewOauthError(services.ErrorInvalidRequest, "more than one method used to send the access token")
+	}
+
+	return token, nil
+}
+
+// clientCredentials are the body parameters a client may authenticate with
+// instead of the Authorization header.
+type clientCredentials struct {
+	ClientId            *string
+	ClientSecret        *string
+	ClientAssertionType *string
+	ClientAssertion     *string
+}
+
+// clientCertificates returns the client certificate chain of a request, leaf
+// first, nil when there is none. It comes from the TLS connection or, behind a
+// proxy terminating TLS, from clientCertificateHeader. PathUnescape leaves the
+// "+" of base64 alone where QueryUnescape would turn it into a space.
+func (h *OauthHandler) clientCertificates(r *http.Request) (certs []*x509.Certificate, err error) {
+	if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+		return r.TLS.PeerCertificates, nil
+	}
+
+	if h.clientCertificateHeader == "" {
+		return nil, nil
+	}
+
+	v := r.Header.Get(h.clientCertificateHeader)
+	if v == "" {
+		return nil, nil
+	}
+
+	data, err := url.PathUnescape(v)
+	if err != nil {
+		return nil, services.NewOauthError(services.ErrorInvalidRequest, "malformed client certificate")
+	}
+
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, services.NewOauthError(services.ErrorInvalidRequest, "malformed client certificate")
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, services.NewOauthError(services.ErrorInvalidRequest, "malformed client certificate")
+	}
+
+	return certs, nil
+}
+
+// clientCertificate returns the leaf of clientCertificates.
+func (h *OauthHandler) clientCertificate(r *http.Request) (cert *x509.Certificate, err error) {
+	certs, err := h.clientCertificates(r)
+	if err != nil || len(certs) == 0 {
+		return nil, err
+	}
+
+	return certs[0], nil
+}
+
+// authenticateClient resolves the client of a /token, /revoke or /introspect
+// request. principal is set when BasicAuth accepted the Authorization header,
+// otherwise the client sends client_secret_post credentials or a private_key_jwt
+// assertion in the body or, for a none client or one authenticating with its TLS
+// certificate, only its client_id. RFC 6749 §2.3 forbids using more than one method.
+func (h *OauthHandler) authenticateClient(r *http.Request, principal interface{}, p *clientCredentials) (client *models.OauthClient, err error) {
+	methods := 0
+	if principal != nil {
+		methods++
+	}
+	if p.ClientSecret != nil {
+		methods++
+	}
+	if p.ClientAssertionType != nil || p.ClientAssertion != nil {
+		methods++
+	}
+
+	if methods > 1 {
+		return nil, services.NewOauthError(services.ErrorInvalidRequest, "more than one client authentication method used")
+	}
+
+	if principal != nil {
+		return principal.(*models.OauthClient), nil
+	}
+
+	clientId := ""
+	if p.ClientId != nil {
+		clientId = *p.ClientId
+	}
+
+	if p.ClientAssertionType != nil || p.ClientAssertion != nil {
+		if p.ClientAssertionType == nil || *p.ClientAssertionType != services.ClientAssertionTypeJwtBearer {
+			return nil, services.NewOauthError(services.ErrorInvalidClient, "unsupported client_assertion_type")
+		}
+
+		if p.ClientAssertion == nil {
+			return nil, services.NewOauthError(services.ErrorInvalidRequest, "client_assertion is required")
+		}
+
+		return h.service.AuthenticateClientAssertion(restful.NewContext(r), clientId, *p.ClientAssertion)
+	}
+
+	if clientId == "" {
+		return nil, services.NewOauthError(services.ErrorInvalidClient, "client authentication failed")
+	}
+
+	if p.ClientSecret == nil {
+		certs, err := h.clientCertificates(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(certs) > 0 {
+			return h.service.AuthenticateClientCertificate(restful.NewContext(r), clientId, certs)
+		}
+
+		return h.service.AuthenticateClient(restful.NewContext(r), services.ClientAuthMethodNone, clientId, "")
+	}
+
+	return h.service.AuthenticateClient(restful.NewContext(r), services.ClientAuthMethodSecretPost, clientId, *p.ClientSecret)
+}
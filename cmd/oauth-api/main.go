@@ -10,6 +10,8 @@ import (
 )
 
 func main() {
+	// restful.Run owns the listener and its TLS settings, mTLS clients are served
+	// behind a proxy forwarding their certificate in OAUTH_MTLS_CLIENT_CERT_HEADER
 	restful.Run(func() (http.Handler, error) {
 		h, err := handler.NewOauthHandler()
 		if err != nil {
@@ -22,9 +24,16 @@ func main() {
 		}
 
 		api := operations.NewOauthAPI(swaggerSpec)
+		api.ServeError = h.ServeError
 		api.BasicAuth = h.BasicAuth
 		api.TokenHandler = operations.TokenHandlerFunc(h.Token)
 		api.MeHandler = operations.MeHandlerFunc(h.Me)
+		api.MePostHandler = operations.MePostHandlerFunc(h.MePost)
+		api.RevokeHandler = operations.RevokeHandlerFunc(h.Revoke)
+		api.IntrospectHandler = operations.IntrospectHandlerFunc(h.Introspect)
+		api.JwksHandler = operations.JwksHandlerFunc(h.Jwks)
+		api.OpenidConfigurationHandler = operations.OpenidConfigurationHandlerFunc(h.OpenidConfiguration)
+		api.OauthAuthorizationServerHandler = operations.OauthAuthorizationServerHandlerFunc(h.OauthAuthorizationServer)
 
 		return api.Serve(nil), nil
 	})
@@ -2,6 +2,7 @@ package handler
 
 import "github.com/NeuronOauth/oauth/models"
 import api "github.com/NeuronOauth/oauth/api-private/gen/models"
+import "strings"
 
 func fromAuthorizationCode(p *models.AuthorizationCode) (r *api.AuthorizationCode) {
 	if p == nil {
@@ -14,3 +15,57 @@ func fromAuthorizationCode(p *models.AuthorizationCode) (r *api.AuthorizationCod
 
 	return r
 }
+
+func fromClient(p *models.OauthClient) (r *api.Client) {
+	if p == nil {
+		return nil
+	}
+
+	r = &api.Client{}
+	r.ClientID = p.ClientId
+	r.AccountID = p.AccountId
+	r.ClientSecret = p.ClientSecret
+	r.RedirectUris = strings.Fields(p.RedirectUri)
+	r.Scope = p.Scope
+	r.ClientType = p.ClientType
+	r.GrantTypes = strings.Fields(p.GrantTypes)
+	r.DefaultScope = p.DefaultScope
+	r.AccessTokenTTL = p.AccessTokenTTL
+	r.RefreshTokenTTL = p.RefreshTokenTTL
+	r.IssueRefreshToken = p.IssueRefreshToken
+	r.TokenEndpointAuthMethod = p.TokenEndpointAuthMethod
+	r.Jwks = p.Jwks
+	r.TLSClientAuthSubjectDn = p.TlsClientAuthSubjectDn
+
+	return r
+}
+
+func fromClientList(p []*models.OauthClient) (r []*api.Client) {
+	r = make([]*api.Client, 0, len(p))
+	for _, v := range p {
+		r = append(r, fromClient(v))
+	}
+
+	return r
+}
+
+func fromScope(p *models.OauthScope) (r *api.Scope) {
+	if p == nil {
+		return nil
+	}
+
+	r = &api.Scope{}
+	r.Scope = p.Scope
+	r.Description = p.Description
+
+	return r
+}
+
+func fromScopeList(p []*models.OauthScope) (r []*api.Scope) {
+	r = make([]*api.Scope, 0, len(p))
+	for _, v := range p {
+		r = append(r, fromScope(v))
+	}
+
+	return r
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"fmt"
 	"github.com/NeuronFramework/errors"
 	"github.com/NeuronFramework/log"
 	"github.com/NeuronFramework/restful"
@@ -19,7 +20,18 @@ type OauthHandler struct {
 func NewOauthHandler() (h *OauthHandler, err error) {
 	h = &OauthHandler{}
 	h.logger = log.TypedLogger(h)
-	h.service, err = services.NewOauthService(&services.OauthServiceOptions{})
+
+	options, err := services.NewOauthServiceOptionsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	// /authorize cannot accept any request without it, refuse to start instead
+	if options.AccountTokenVerifier == nil {
+		return nil, fmt.Errorf("OAUTH_ACCOUNT_JWT_KEYS or OAUTH_ACCOUNT_JWT_JWKS_FILE is required")
+	}
+
+	h.service, err = services.NewOauthService(options)
 	if err != nil {
 		return nil, err
 	}
@@ -28,18 +40,175 @@ func NewOauthHandler() (h *OauthHandler, err error) {
 }
 
 func (h *OauthHandler) Authorize(p operations.AuthorizeParams) middleware.Responder {
-	authorizationCode, err := h.service.Authorize(restful.NewContext(p.HTTPRequest), &models.AuthorizeParams{
+	params := &models.AuthorizeParams{
 		AccountJwt:   p.AccountJwt,
 		ClientID:     p.ClientID,
 		RedirectURI:  p.RedirectURI,
 		ResponseType: p.ResponseType,
 		State:        p.State,
 		Scope:        p.Scope,
-	})
+	}
+	if p.CodeChallenge != nil {
+		params.CodeChallenge = *p.CodeChallenge
+	}
+	if p.CodeChallengeMethod != nil {
+		params.CodeChallengeMethod = *p.CodeChallengeMethod
+	}
+	if p.Nonce != nil {
+		params.Nonce = *p.Nonce
+	}
+
+	authorizationCode, err := h.service.Authorize(restful.NewContext(p.HTTPRequest), params)
 
 	if err != nil {
+		// the login front end forwards the OAuth error code to the client's redirect_uri
+		if e, ok := err.(*services.OauthError); ok {
+			return errors.InvalidParam(e.Code + ": " + e.Description)
+		}
 		return errors.Wrap(err)
 	}
 
 	return operations.NewAuthorizeOK().WithPayload(fromAuthorizationCode(authorizationCode))
 }
+
+func (h *OauthHandler) CreateClient(p operations.CreateClientParams) middleware.Responder {
+	params := &models.OauthClientParams{
+		RedirectUri:             p.RedirectURI,
+		Scope:                   p.Scope,
+		ClientType:              p.ClientType,
+		GrantTypes:              p.GrantTypes,
+		DefaultScope:            p.DefaultScope,
+		AccessTokenTTL:          p.AccessTokenTTL,
+		RefreshTokenTTL:         p.RefreshTokenTTL,
+		IssueRefreshToken:       p.IssueRefreshToken,
+		TokenEndpointAuthMethod: p.TokenEndpointAuthMethod,
+		Jwks:                    p.Jwks,
+		TlsClientAuthSubjectDn:  p.TLSClientAuthSubjectDn,
+	}
+
+	client, err := h.service.CreateClient(restful.NewContext(p.HTTPRequest), p.AccountID, params)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	return operations.NewCreateClientOK().WithPayload(fromClient(client))
+}
+
+func (h *OauthHandler) GetClient(p operations.GetClientParams) middleware.Responder {
+	client, err := h.service.GetClient(restful.NewContext(p.HTTPRequest), p.ClientID)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	return operations.NewGetClientOK().WithPayload(fromClient(client))
+}
+
+func (h *OauthHandler) ListClients(p operations.ListClientsParams) middleware.Responder {
+	page := int64(0)
+	if p.Page != nil {
+		page = *p.Page
+	}
+
+	pageSize := int64(0)
+	if p.PageSize != nil {
+		pageSize = *p.PageSize
+	}
+
+	clients, err := h.service.ListClients(restful.NewContext(p.HTTPRequest), p.AccountID, page, pageSize)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	return operations.NewListClientsOK().WithPayload(fromClientList(clients))
+}
+
+func (h *OauthHandler) UpdateClient(p operations.UpdateClientParams) middleware.Responder {
+	params := &models.OauthClientParams{
+		RedirectUri:             p.RedirectURI,
+		Scope:                   p.Scope,
+		ClientType:              p.ClientType,
+		GrantTypes:              p.GrantTypes,
+		DefaultScope:            p.DefaultScope,
+		AccessTokenTTL:          p.AccessTokenTTL,
+		RefreshTokenTTL:         p.RefreshTokenTTL,
+		IssueRefreshToken:       p.IssueRefreshToken,
+		TokenEndpointAuthMethod: p.TokenEndpointAuthMethod,
+		Jwks:                    p.Jwks,
+		TlsClientAuthSubjectDn:  p.TLSClientAuthSubjectDn,
+	}
+
+	client, err := h.service.UpdateClient(restful.NewContext(p.HTTPRequest), p.ClientID, params)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	return operations.NewUpdateClientOK().WithPayload(fromClient(client))
+}
+
+func (h *OauthHandler) RotateClientSecret(p operations.RotateClientSecretParams) middleware.Responder {
+	client, err := h.service.RotateClientSecret(restful.NewContext(p.HTTPRequest), p.ClientID)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	return operations.NewRotateClientSecretOK().WithPayload(fromClient(client))
+}
+
+func (h *OauthHandler) DeleteClient(p operations.DeleteClientParams) middleware.Responder {
+	err := h.service.DeleteClient(restful.NewContext(p.HTTPRequest), p.ClientID)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	return operations.NewDeleteClientOK()
+}
+
+func (h *OauthHandler) CreateScope(p operations.CreateScopeParams) middleware.Responder {
+	description := ""
+	if p.Description != nil {
+		description = *p.Description
+	}
+
+	scope, err := h.service.CreateScope(restful.NewContext(p.HTTPRequest), p.Scope, description)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	return operations.NewCreateScopeOK().WithPayload(fromScope(scope))
+}
+
+func (h *OauthHandler) GetScope(p operations.GetScopeParams) middleware.Responder {
+	scope, err := h.service.GetScope(restful.NewContext(p.HTTPRequest), p.Scope)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	return operations.NewGetScopeOK().WithPayload(fromScope(scope))
+}
+
+func (h *OauthHandler) ListScopes(p operations.ListScopesParams) middleware.Responder {
+	scopes, err := h.service.ListScopes(restful.NewContext(p.HTTPRequest))
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	return operations.NewListScopesOK().WithPayload(fromScopeList(scopes))
+}
+
+func (h *OauthHandler) UpdateScope(p operations.UpdateScopeParams) middleware.Responder {
+	scope, err := h.service.UpdateScope(restful.NewContext(p.HTTPRequest), p.Scope, p.Description)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	return operations.NewUpdateScopeOK().WithPayload(fromScope(scope))
+}
+
+func (h *OauthHandler) DeleteScope(p operations.DeleteScopeParams) middleware.Responder {
+	err := h.service.DeleteScope(restful.NewContext(p.HTTPRequest), p.Scope)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	return operations.NewDeleteScopeOK()
+}
@@ -23,6 +23,17 @@ func main() {
 
 		api := operations.NewOauthPrivateAPI(swaggerSpec)
 		api.AuthorizeHandler = operations.AuthorizeHandlerFunc(h.Authorize)
+		api.CreateClientHandler = operations.CreateClientHandlerFunc(h.CreateClient)
+		api.GetClientHandler = operations.GetClientHandlerFunc(h.GetClient)
+		api.ListClientsHandler = operations.ListClientsHandlerFunc(h.ListClients)
+		api.UpdateClientHandler = operations.UpdateClientHandlerFunc(h.UpdateClient)
+		api.RotateClientSecretHandler = operations.RotateClientSecretHandlerFunc(h.RotateClientSecret)
+		api.DeleteClientHandler = operations.DeleteClientHandlerFunc(h.DeleteClient)
+		api.CreateScopeHandler = operations.CreateScopeHandlerFunc(h.CreateScope)
+		api.GetScopeHandler = operations.GetScopeHandlerFunc(h.GetScope)
+		api.ListScopesHandler = operations.ListScopesHandlerFunc(h.ListScopes)
+		api.UpdateScopeHandler = operations.UpdateScopeHandlerFunc(h.UpdateScope)
+		api.DeleteScopeHandler = operations.DeleteScopeHandlerFunc(h.DeleteScope)
 
 		return api.Serve(nil), nil
 	})
@@ -1,28 +1,63 @@
 package models
 
 type OauthClient struct {
-	ClientId     string
-	AccountId    string
-	PasswordHash string
-	RedirectUri  string
+	ClientId                string
+	AccountId               string
+	PasswordHash            string
+	RedirectUri             string
+	Scope                   string
+	ClientSecret            string
+	ClientType              string
+	GrantTypes              string
+	DefaultScope            string
+	AccessTokenTTL          int64
+	RefreshTokenTTL         int64
+	IssueRefreshToken       bool
+	TokenEndpointAuthMethod string
+	Jwks                    string
+	TlsClientAuthSubjectDn  string
+	// CertificateThumbprint is the x5t#S256 of the certificate the client
+	// authenticated with, its tokens are bound to it.
+	CertificateThumbprint string
+}
+
+// OauthClientParams carries the settings of a client being created or updated,
+// nil fields keep their default or current value.
+type OauthClientParams struct {
+	RedirectUri             *string
+	Scope                   *string
+	ClientType              *string
+	GrantTypes              *string
+	DefaultScope            *string
+	AccessTokenTTL          *int64
+	RefreshTokenTTL         *int64
+	IssueRefreshToken       *bool
+	TokenEndpointAuthMethod *string
+	Jwks                    *string
+	TlsClientAuthSubjectDn  *string
 }
 
 type AuthorizeParams struct {
-	AccountJwt   string
-	ResponseType string
-	ClientID     string
-	Scope        string
-	RedirectURI  string
-	State        string
+	AccountJwt          string
+	ResponseType        string
+	ClientID            string
+	Scope               string
+	RedirectURI         string
+	State               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	Nonce               string
 }
 
 type AuthorizationCode struct {
-	Code          string
-	ClientId      string
-	AccountId     string
-	Scope         string
-	RedirectUri   string
-	ExpireSeconds int64
+	Code                string
+	ClientId            string
+	AccountId           string
+	Scope               string
+	RedirectUri         string
+	ExpireSeconds       int64
+	CodeChallenge       string
+	CodeChallengeMethod string
 }
 
 type AccessToken struct {
@@ -33,4 +68,66 @@ type AccessToken struct {
 	Scope        string
 	ExpiresIn    int64
 	RefreshToken string
+	IdToken      string
+}
+
+type TokenIntrospection struct {
+	Active    bool
+	Scope     string
+	ClientId  string
+	AccountId string
+	ExpiresAt int64
+	IssuedAt  int64
+	TokenType string
+	// CertThumbprint is the x5t#S256 the token is bound to, if any.
+	CertThumbprint string
+}
+
+type JSONWebKey struct {
+	Kty string
+	Use string
+	Kid string
+	Alg string
+	N   string
+	E   string
+	Crv string
+	X   string
+	Y   string
+}
+
+type JSONWebKeySet struct {
+	Keys []*JSONWebKey
+}
+
+type UserInfo struct {
+	Subject string
+}
+
+type ServerMetadata struct {
+	Issuer                                             string
+	AuthorizationEndpoint                              string
+	TokenEndpoint                                      string
+	JwksUri                                            string
+	UserinfoEndpoint                                   string
+	RevocationEndpoint                                 string
+	IntrospectionEndpoint                              string
+	ScopesSupported                                    []string
+	ResponseTypesSupported                             []string
+	GrantTypesSupported                                []string
+	TokenEndpointAuthMethodsSupported                  []string
+	RevocationEndpointAuthMethodsSupported             []string
+	IntrospectionEndpointAuthMethodsSupported          []string
+	TokenEndpointAuthSigningAlgValuesSupported         []string
+	RevocationEndpointAuthSigningAlgValuesSupported    []string
+	IntrospectionEndpointAuthSigningAlgValuesSupported []string
+	CodeChallengeMethodsSupported                      []string
+	TlsClientCertificateBoundAccessTokens              bool
+	SubjectTypesSupported                              []string
+	IdTokenSigningAlgValuesSupported                   []string
+	ClaimsSupported                                    []string
+}
+
+type OauthScope struct {
+	Scope       string
+	Description string
 }
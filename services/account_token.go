@@ -0,0 +1,125 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/NeuronFramework/errors"
+	"github.com/dgrijalva/jwt-go"
+	"time"
+)
+
+// AccountClaims is what /authorize needs to know about the signed-in account.
+type AccountClaims struct {
+	AccountId string
+	// AuthTime is when the account authenticated, as a unix time.
+	AuthTime int64
+}
+
+// AccountTokenVerifier checks the accountJwt handed to /authorize by the login
+// front end and returns the account it identifies.
+type AccountTokenVerifier interface {
+	Verify(token string) (claims *AccountClaims, err error)
+}
+
+// JwtAccountTokenVerifier verifies account JWTs against a set of keys selected by
+// kid, accepting only whitelisted algorithms and the expected issuer and audience.
+type JwtAccountTokenVerifier struct {
+	// Keys maps kid to a verification key: []byte for HS*, *rsa.PublicKey for RS*
+	// and *ecdsa.PublicKey for ES*. A single key may be registered under "" to
+	// verify tokens that carry no kid.
+	Keys       map[string]interface{}
+	Algorithms []string
+	Issuer     string
+	Audience   string
+	Leeway     time.Duration
+}
+
+func (v *JwtAccountTokenVerifier) Verify(token string) (claims *AccountClaims, err error) {
+	c := &accountTokenClaims{}
+	parser := &jwt.Parser{ValidMethods: v.Algorithms, SkipClaimsValidation: true}
+	_, err = parser.ParseWithClaims(token, c, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		key, ok := v.Keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown kid %q", kid)
+		}
+
+		return key, nil
+	})
+	if err != nil {
+		return nil, errors.InvalidParam("无效的AccountJwt")
+	}
+
+	err = c.verify(v.Issuer, v.Audience, time.Now(), v.Leeway)
+	if err != nil {
+		return nil, err
+	}
+
+	claims = &AccountClaims{}
+	claims.AccountId = c.Subject
+	claims.AuthTime = c.AuthTime
+	if claims.AuthTime == 0 {
+		claims.AuthTime = c.IssuedAt
+	}
+
+	return claims, nil
+}
+
+// audience accepts both forms of the aud claim (RFC 7519 §4.1.3).
+type audience []string
+
+func (a *audience) UnmarshalJSON(b []byte) error {
+	var s string
+	if json.Unmarshal(b, &s) == nil {
+		*a = audience{s}
+		return nil
+	}
+
+	var list []string
+	err := json.Unmarshal(b, &list)
+	if err != nil {
+		return err
+	}
+
+	*a = list
+	return nil
+}
+
+type accountTokenClaims struct {
+	Issuer    string   `json:"iss"`
+	Subject   string   `json:"sub"`
+	Audience  audience `json:"aud"`
+	ExpiresAt int64    `json:"exp"`
+	NotBefore int64    `json:"nbf"`
+	IssuedAt  int64    `json:"iat"`
+	AuthTime  int64    `json:"auth_time"`
+}
+
+// Valid is left to verify, which knows the expected issuer and audience.
+func (c *accountTokenClaims) Valid() error {
+	return nil
+}
+
+func (c *accountTokenClaims) verify(issuer string, aud string, now time.Time, leeway time.Duration) error {
+	if c.Subject == "" {
+		return errors.InvalidParam("AccountJwt缺少sub")
+	}
+
+	if c.Issuer != issuer {
+		return errors.InvalidParam("AccountJwt的iss不匹配")
+	}
+
+	if !containsString(c.Audience, aud) {
+		return errors.InvalidParam("AccountJwt的aud不匹配")
+	}
+
+	if c.ExpiresAt == 0 || now.Add(-leeway).Unix() > c.ExpiresAt {
+		return errors.InvalidParam("AccountJwt已过期")
+	}
+
+	if c.NotBefore != 0 && now.Add(leeway).Unix() < c.NotBefore {
+		return errors.InvalidParam("AccountJwt尚未生效")
+	}
+
+	return nil
+}
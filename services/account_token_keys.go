@@ -0,0 +1,254 @@
+package services
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+)
+
+// accountTokenVerifierFromEnv builds a JwtAccountTokenVerifier from the key files
+// in OAUTH_ACCOUNT_JWT_KEYS (comma separated, each optionally prefixed with "kid="),
+// the HMAC secret files in OAUTH_ACCOUNT_JWT_HMAC_KEYS (same format) and the JWK
+// set in OAUTH_ACCOUNT_JWT_JWKS_FILE. OAUTH_ACCOUNT_JWT_ALGORITHMS is the comma
+// separated algorithm whitelist, OAUTH_ACCOUNT_JWT_ISSUER and
+// OAUTH_ACCOUNT_JWT_AUDIENCE the expected iss and aud. A key file must hold a PEM
+// public key or certificate, HMAC secrets are only taken from their own variable
+// so that a public key can never be mistaken for one.
+func accountTokenVerifierFromEnv(leeway time.Duration) (verifier *JwtAccountTokenVerifier, err error) {
+	keysEnv := os.Getenv("OAUTH_ACCOUNT_JWT_KEYS")
+	hmacKeysEnv := os.Getenv("OAUTH_ACCOUNT_JWT_HMAC_KEYS")
+	jwksFile := os.Getenv("OAUTH_ACCOUNT_JWT_JWKS_FILE")
+	if keysEnv == "" && hmacKeysEnv == "" && jwksFile == "" {
+		return nil, nil
+	}
+
+	verifier = &JwtAccountTokenVerifier{}
+	verifier.Keys = make(map[string]interface{})
+	verifier.Issuer = os.Getenv("OAUTH_ACCOUNT_JWT_ISSUER")
+	verifier.Audience = os.Getenv("OAUTH_ACCOUNT_JWT_AUDIENCE")
+	verifier.Leeway = leeway
+
+	for _, v := range strings.Split(os.Getenv("OAUTH_ACCOUNT_JWT_ALGORITHMS"), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			verifier.Algorithms = append(verifier.Algorithms, v)
+		}
+	}
+
+	if len(verifier.Algorithms) == 0 {
+		return nil, fmt.Errorf("OAUTH_ACCOUNT_JWT_ALGORITHMS is required")
+	}
+
+	if verifier.Issuer == "" || verifier.Audience == "" {
+		return nil, fmt.Errorf("OAUTH_ACCOUNT_JWT_ISSUER and OAUTH_ACCOUNT_JWT_AUDIENCE are required")
+	}
+
+	for kid, path := range keyFilesFromEnv(keysEnv) {
+		verifier.Keys[kid], err = loadVerificationKey(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for kid, path := range keyFilesFromEnv(hmacKeysEnv) {
+		verifier.Keys[kid], err = loadHmacKey(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if jwksFile != "" {
+		keys, err := loadJwksFile(jwksFile)
+		if err != nil {
+			return nil, err
+		}
+
+		for kid, key := range keys {
+			verifier.Keys[kid] = key
+		}
+	}
+
+	return verifier, nil
+}
+
+// keyFilesFromEnv splits a comma separated list of key files, each optionally
+// prefixed with "kid=", into paths keyed by kid.
+func keyFilesFromEnv(v string) (files map[string]string) {
+	files = make(map[string]string)
+	for _, v := range strings.Split(v, ",") {
+		if v = strings.TrimSpace(v); v == "" {
+			continue
+		}
+
+		kid, path := "", v
+		if i := strings.Index(v, "="); i >= 0 {
+			kid, path = v[:i], v[i+1:]
+		}
+		files[kid] = path
+	}
+
+	return files
+}
+
+func loadHmacKey(path string) (key []byte, err error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key = bytes.TrimRight(data, "\r\n")
+	if len(key) == 0 {
+		return nil, fmt.Errorf("%s: empty HMAC secret", path)
+	}
+
+	return key, nil
+}
+
+func loadVerificationKey(path string) (key interface{}, err error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err = parseVerificationKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	return key, nil
+}
+
+// parseVerificationKey reads a PEM public key or certificate.
+func parseVerificationKey(data []byte) (key interface{}, err error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("not a PEM public key or certificate")
+	}
+
+	switch block.Type {
+	case "PUBLIC KEY":
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return cert.PublicKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %s", block.Type)
+	}
+}
+
+func loadJwksFile(path string) (keys map[string]interface{}, err error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err = parseJwks(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	return keys, nil
+}
+
+// parseJwks reads the RSA, EC (P-256, P-384, P-521), Ed25519 and oct signing keys
+// of a JWK set, keyed by kid. Keys of other types are skipped, a set may hold keys
+// meant for other parties.
+func parseJwks(data []byte) (keys map[string]interface{}, err error) {
+	jwks := struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+			Crv string `json:"crv"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+			K   string `json:"k"`
+		} `json:"keys"`
+	}{}
+	err = json.Unmarshal(data, &jwks)
+	if err != nil {
+		return nil, err
+	}
+
+	keys = make(map[string]interface{})
+	for _, v := range jwks.Keys {
+		if v.Use != "" && v.Use != "sig" {
+			continue
+		}
+
+		switch v.Kty {
+		case "RSA":
+			n, err := base64.RawURLEncoding.DecodeString(v.N)
+			if err != nil {
+				return nil, fmt.Errorf("kid %s: %v", v.Kid, err)
+			}
+			e, err := base64.RawURLEncoding.DecodeString(v.E)
+			if err != nil {
+				return nil, fmt.Errorf("kid %s: %v", v.Kid, err)
+			}
+			keys[v.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		case "EC":
+			curve, ok := jwkCurves[v.Crv]
+			if !ok {
+				continue
+			}
+			x, err := base64.RawURLEncoding.DecodeString(v.X)
+			if err != nil {
+				return nil, fmt.Errorf("kid %s: %v", v.Kid, err)
+			}
+			y, err := base64.RawURLEncoding.DecodeString(v.Y)
+			if err != nil {
+				return nil, fmt.Errorf("kid %s: %v", v.Kid, err)
+			}
+			key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+			if !curve.IsOnCurve(key.X, key.Y) {
+				return nil, fmt.Errorf("kid %s: point is not on curve %s", v.Kid, v.Crv)
+			}
+			keys[v.Kid] = key
+		case "OKP":
+			if v.Crv != "Ed25519" {
+				continue
+			}
+			x, err := base64.RawURLEncoding.DecodeString(v.X)
+			if err != nil {
+				return nil, fmt.Errorf("kid %s: %v", v.Kid, err)
+			}
+			if len(x) != ed25519.PublicKeySize {
+				return nil, fmt.Errorf("kid %s: invalid Ed25519 key", v.Kid)
+			}
+			keys[v.Kid] = ed25519.PublicKey(x)
+		case "oct":
+			k, err := base64.RawURLEncoding.DecodeString(v.K)
+			if err != nil {
+				return nil, fmt.Errorf("kid %s: %v", v.Kid, err)
+			}
+			keys[v.Kid] = k
+		}
+	}
+
+	return keys, nil
+}
+
+var jwkCurves = map[string]elliptic.Curve{
+	"P-256": elliptic.P256(),
+	"P-384": elliptic.P384(),
+	"P-521": elliptic.P521(),
+}
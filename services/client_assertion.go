@@ -0,0 +1,229 @@
+package services
+
+import (
+	"fmt"
+	"github.com/NeuronFramework/restful"
+	"github.com/NeuronFramework/sql/wrap"
+	"github.com/NeuronOauth/oauth/models"
+	"github.com/NeuronOauth/oauth/storages/oauth_db"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/go-sql-driver/mysql"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+)
+
+// ClientAssertionTypeJwtBearer is the client_assertion_type of RFC 7523 §2.2.
+const ClientAssertionTypeJwtBearer = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// clientAssertionMaxLifetime bounds how far exp may lie ahead, and with it how
+// long a jti has to be remembered.
+const clientAssertionMaxLifetime = time.Hour
+
+// the asymmetric algorithms a client may sign its assertion with, HMAC would
+// need a shared secret which is what private_key_jwt replaces
+var clientAssertionAlgorithms = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+	"EdDSA",
+}
+
+type clientAssertionClaims struct {
+	Issuer    string   `json:"iss"`
+	Subject   string   `json:"sub"`
+	Audience  audience `json:"aud"`
+	ExpiresAt int64    `json:"exp"`
+	NotBefore int64    `json:"nbf"`
+	Id        string   `json:"jti"`
+}
+
+// Valid is left to AuthenticateClientAssertion, which knows the client.
+func (c *clientAssertionClaims) Valid() error {
+	return nil
+}
+
+// parseClientKeys reads the keys a client registered for private_key_jwt, either
+// a JWK set or a single PEM public key.
+func parseClientKeys(doc string) (keys map[string]interface{}, err error) {
+	doc = strings.TrimSpace(doc)
+	if strings.HasPrefix(doc, "{") {
+		keys, err = parseJwks([]byte(doc))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		key, err := parseVerificationKey([]byte(doc))
+		if err != nil {
+			return nil, err
+		}
+		keys = map[string]interface{}{"": key}
+	}
+
+	for kid, key := range keys {
+		if _, ok := key.([]byte); ok {
+			return nil, fmt.Errorf("kid %s: not a public key", kid)
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no signing keys")
+	}
+
+	return keys, nil
+}
+
+// AuthenticateClientAssertion implements private_key_jwt (RFC 7523 §2.2 and §3).
+// clientId is optional, the assertion's sub names the client.
+func (s *OauthService) AuthenticateClientAssertion(ctx *restful.Context, clientId string, assertion string) (c *models.OauthClient, err error) {
+	authFailed := NewOauthError(ErrorInvalidClient, "client authentication failed")
+
+	if s.options.Issuer == "" {
+		return nil, NewOauthError(ErrorInvalidClient, "private_key_jwt needs the issuer to be configured")
+	}
+
+	unverified := &clientAssertionClaims{}
+	_, _, err = new(jwt.Parser).ParseUnverified(assertion, unverified)
+	if err != nil || unverified.Subject == "" {
+		return nil, authFailed
+	}
+
+	if clientId != "" && clientId != unverified.Subject {
+		return nil, authFailed
+	}
+
+	dbClient, err := s.oauthDB.OauthClient.GetQuery().ClientId_Equal(unverified.Subject).QueryOne(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if dbClient == nil || dbClient.TokenEndpointAuthMethod != ClientAuthMethodPrivateKeyJwt {
+		return nil, authFailed
+	}
+
+	keys, err := parseClientKeys(dbClient.Jwks)
+	if err != nil {
+		s.logger.Warn("parseClientKeys", zap.String("clientId", dbClient.ClientId), zap.Error(err))
+		return nil, authFailed
+	}
+
+	claims := &clientAssertionClaims{}
+	parser := &jwt.Parser{ValidMethods: clientAssertionAlgorithms, SkipClaimsValidation: true}
+	_, err = parser.ParseWithClaims(assertion, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		if key, ok := keys[kid]; ok {
+			return key, nil
+		}
+
+		// a client with a single key need not name it
+		if kid == "" && len(keys) == 1 {
+			for _, key := range keys {
+				return key, nil
+			}
+		}
+
+		return nil, fmt.Errorf("unknown kid %q", kid)
+	})
+	if err != nil {
+		return nil, authFailed
+	}
+
+	// the token endpoint, or the issuer identifying the whole server, is the audience
+	baseUrl := strings.TrimSuffix(s.options.Issuer, "/")
+	if !containsString(claims.Audience, s.options.Issuer) && !containsString(claims.Audience, baseUrl+"/token") {
+		return nil, authFailed
+	}
+
+	if claims.Issuer != dbClient.ClientId || claims.Subject != dbClient.ClientId {
+		return nil, authFailed
+	}
+
+	now := time.Now()
+	if claims.ExpiresAt == 0 || now.Add(-s.options.ClockSkew).Unix() > claims.ExpiresAt {
+		return nil, NewOauthError(ErrorInvalidClient, "client assertion expired")
+	}
+
+	if claims.ExpiresAt > now.Add(clientAssertionMaxLifetime+s.options.ClockSkew).Unix() {
+		return nil, NewOauthError(ErrorInvalidClient, "client assertion expires too far in the future")
+	}
+
+	if claims.NotBefore != 0 && now.Add(s.options.ClockSkew).Unix() < claims.NotBefore {
+		return nil, authFailed
+	}
+
+	if claims.Id == "" || len(claims.Id) > 128 {
+		return nil, NewOauthError(ErrorInvalidClient, "client assertion needs a jti of at most 128 characters")
+	}
+
+	err = s.recordClientAssertion(ctx, dbClient.ClientId, claims.Id, claims.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return oauth_db.FromOauthClient(dbClient), nil
+}
+
+// recordClientAssertion remembers a jti until its assertion expires, seeing it
+// again within that time is a replay.
+func (s *OauthService) recordClientAssertion(ctx *restful.Context, clientId string, jti string, expireTime int64) (err error) {
+	now := time.Now().Add(-s.options.ClockSkew).Unix()
+
+	err = s.oauthDB.TransactionReadCommitted(ctx, func(tx *wrap.Tx) (err error) {
+		dbClientAssertion, err := s.oauthDB.ClientAssertion.GetQuery().
+			ClientId_Equal(clientId).And().Jti_Equal(jti).
+			ForUpdate().
+			QueryOne(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		if dbClientAssertion != nil {
+			if dbClientAssertion.ExpireTime >= now {
+				return NewOauthError(ErrorInvalidClient, "client assertion replayed")
+			}
+
+			dbClientAssertion.ExpireTime = expireTime
+			return s.oauthDB.ClientAssertion.Update(ctx, tx, dbClientAssertion)
+		}
+
+		dbClientAssertion = &oauth_db.ClientAssertion{}
+		dbClientAssertion.ClientId = clientId
+		dbClientAssertion.Jti = jti
+		dbClientAssertion.ExpireTime = expireTime
+		_, err = s.oauthDB.ClientAssertion.Insert(ctx, tx, dbClientAssertion)
+		// a concurrent request inserted the same jti first
+		if e, ok := err.(*mysql.MySQLError); ok && e.Number == 1062 {
+			return NewOauthError(ErrorInvalidClient, "client assertion replayed")
+		}
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	s.deleteExpiredClientAssertions(ctx, now)
+
+	return nil
+}
+
+// deleteExpiredClientAssertions keeps the replay cache small, a few rows at a
+// time so no single request pays for a large cleanup. Failures are logged only.
+func (s *OauthService) deleteExpiredClientAssertions(ctx *restful.Context, now int64) {
+	dbClientAssertions, err := s.oauthDB.ClientAssertion.GetQuery().
+		ExpireTime_Less(now).
+		Limit(0, 100).
+		QueryList(ctx, nil)
+	if err != nil {
+		s.logger.Error("ClientAssertion.QueryList", zap.Error(err))
+		return
+	}
+
+	for _, v := range dbClientAssertions {
+		err = s.oauthDB.ClientAssertion.Delete(ctx, nil, v.Id)
+		if err != nil {
+			s.logger.Error("ClientAssertion.Delete", zap.Error(err))
+			return
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"github.com/NeuronFramework/restful"
+	"github.com/NeuronOauth/oauth/models"
+	"github.com/NeuronOauth/oauth/storages/oauth_db"
+)
+
+// CertificateThumbprint is the RFC 8705 x5t#S256 of a certificate, the value
+// its tokens are bound to.
+func CertificateThumbprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// AuthenticateClientCertificate authenticates a client that sent only its client_id
+// over a connection with a client certificate. certs is the peer chain, leaf first.
+// A none client is accepted as without a certificate, its tokens are left unbound.
+func (s *OauthService) AuthenticateClientCertificate(ctx *restful.Context, clientId string, certs []*x509.Certificate) (c *models.OauthClient, err error) {
+	dbClient, err := s.oauthDB.OauthClient.GetQuery().ClientId_Equal(clientId).QueryOne(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if dbClient == nil {
+		return nil, NewOauthError(ErrorInvalidClient, "client authentication failed")
+	}
+
+	// a client registered while the method was enabled stays locked out until it is again
+	if !containsString(s.clientAuthMethods(), dbClient.TokenEndpointAuthMethod) {
+		return nil, NewOauthError(ErrorInvalidClient, "client authentication method not allowed for this client")
+	}
+
+	switch dbClient.TokenEndpointAuthMethod {
+	case ClientAuthMethodNone:
+		return oauth_db.FromOauthClient(dbClient), nil
+	case ClientAuthMethodTlsClientAuth:
+		err = s.verifyPkiCertificate(dbClient, certs)
+	case ClientAuthMethodSelfSignedTlsClientAuth:
+		err = s.verifySelfSignedCertificate(dbClient, certs[0])
+	default:
+		return nil, NewOauthError(ErrorInvalidClient, "client authentication method not allowed for this client")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c = oauth_db.FromOauthClient(dbClient)
+	c.CertificateThumbprint = CertificateThumbprint(certs[0])
+
+	return c, nil
+}
+
+// verifyPkiCertificate checks the chain against MtlsClientCAs and the leaf's
+// subject against the registered DN (RFC 8705 §2.1.2).
+func (s *OauthService) verifyPkiCertificate(dbClient *oauth_db.OauthClient, certs []*x509.Certificate) (err error) {
+	if s.options.MtlsClientCAs == nil {
+		return NewOauthError(ErrorInvalidClient, "tls_client_auth is not configured")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, v := range certs[1:] {
+		intermediates.AddCert(v)
+	}
+
+	_, err = certs[0].Verify(x509.VerifyOptions{
+		Roots:         s.options.MtlsClientCAs,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return NewOauthError(ErrorInvalidClient, "client certificate is not trusted")
+	}
+
+	if dbClient.TlsClientAuthSubjectDn == "" || certs[0].Subject.String() != dbClient.TlsClientAuthSubjectDn {
+		return NewOauthError(ErrorInvalidClient, "client certificate subject does not match")
+	}
+
+	return nil
+}
+
+// verifySelfSignedCertificate requires the certificate's public key to be one of
+// the client's registered keys (RFC 8705 §2.2.2). Validity dates are not checked,
+// the registration is what the certificate is trusted by.
+func (s *OauthService) verifySelfSignedCertificate(dbClient *oauth_db.OauthClient, cert *x509.Certificate) (err error) {
+	keys, err := parseClientKeys(dbClient.Jwks)
+	if err != nil {
+		return NewOauthError(ErrorInvalidClient, "client has no usable keys")
+	}
+
+	certKey, err := x509.MarshalPKIXPublicKey(cert.PublicKey)
+	if err != nil {
+		return NewOauthError(ErrorInvalidClient, "unsupported client certificate key")
+	}
+
+	for _, key := range keys {
+		der, err := x509.MarshalPKIXPublicKey(key)
+		if err == nil && bytes.Equal(der, certKey) {
+			return nil
+		}
+	}
+
+	return NewOauthError(ErrorInvalidClient, "client certificate does not match a registered key")
+}
+
+// checkCertificateBinding verifies a token bound to thumbprint is presented over
+// a connection with the same certificate. Unbound tokens pass.
+func checkCertificateBinding(thumbprint string, cert *x509.Certificate) bool {
+	if thumbprint == "" {
+		return true
+	}
+
+	return cert != nil && CertificateThumbprint(cert) == thumbprint
+}
@@ -0,0 +1,211 @@
+package services
+
+import (
+	"github.com/NeuronFramework/errors"
+	"github.com/NeuronFramework/restful"
+	"github.com/NeuronOauth/oauth/models"
+	"github.com/NeuronOauth/oauth/storages/oauth_db"
+	"strings"
+	"time"
+)
+
+const (
+	ClientTypeConfidential = "confidential"
+	ClientTypePublic       = "public"
+)
+
+// clientGrantTypes returns the grants a client may use. Clients registered before
+// grants were configurable have none stored and keep the grants they always had,
+// client_credentials has to be granted explicitly.
+func (s *OauthService) clientGrantTypes(client *models.OauthClient) []string {
+	grantTypes := strings.Fields(client.GrantTypes)
+	if len(grantTypes) == 0 {
+		return []string{GrantTypeAuthorizationCode, GrantTypeRefreshToken}
+	}
+
+	return grantTypes
+}
+
+func (s *OauthService) checkClientGrant(client *models.OauthClient, grantType string) (err error) {
+	if !containsString(s.clientGrantTypes(client), grantType) {
+		return NewOauthError(ErrorUnauthorizedClient, "grant_type is not allowed for this client")
+	}
+
+	if grantType == GrantTypeClientCredentials && client.ClientType == ClientTypePublic {
+		return NewOauthError(ErrorUnauthorizedClient, "grant_type is not allowed for public clients")
+	}
+
+	return nil
+}
+
+// resolveClientScope applies a client's default and allowed scopes to a requested scope.
+// A client without allowed scopes may request any scope the end-user consents to,
+// except with client_credentials where there is no end-user and it gets none.
+func (s *OauthService) resolveClientScope(ctx *restful.Context, client *models.OauthClient, grantType string, scope string) (scopes []string, err error) {
+	if len(parseScope(scope)) == 0 {
+		scope = client.DefaultScope
+	}
+
+	scopes = normalizeScope(parseScope(scope))
+	known, err := s.knownScopes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range scopes {
+		if !known[v] {
+			return nil, NewOauthError(ErrorInvalidScope, "unknown scope "+v)
+		}
+	}
+
+	allowedScopes := parseScope(client.Scope)
+	if (len(allowedScopes) > 0 || grantType == GrantTypeClientCredentials) && !scopeAllowed(scopes, allowedScopes) {
+		return nil, NewOauthError(ErrorInvalidScope, "scope is not allowed for this client")
+	}
+
+	return scopes, nil
+}
+
+func (s *OauthService) accessTokenLifetime(client *models.OauthClient) time.Duration {
+	if client.AccessTokenTTL > 0 {
+		return time.Duration(client.AccessTokenTTL) * time.Second
+	}
+
+	return s.options.AccessTokenLifetime
+}
+
+func (s *OauthService) refreshTokenIdleTimeout(client *models.OauthClient) time.Duration {
+	if client.RefreshTokenTTL > 0 {
+		return time.Duration(client.RefreshTokenTTL) * time.Second
+	}
+
+	return s.options.RefreshTokenIdleTimeout
+}
+
+// applyClientParams validates p and copies the fields it sets onto dbClient.
+func (s *OauthService) applyClientParams(ctx *restful.Context, dbClient *oauth_db.OauthClient, p *models.OauthClientParams) (err error) {
+	if p.RedirectUri != nil {
+		redirectUris, err := checkRedirectUris(*p.RedirectUri)
+		if err != nil {
+			return err
+		}
+
+		dbClient.RedirectUri = strings.Join(redirectUris, " ")
+	}
+
+	if p.Scope != nil {
+		scopes, err := s.checkScope(ctx, *p.Scope)
+		if err != nil {
+			return err
+		}
+
+		dbClient.OauthScope = strings.Join(scopes, " ")
+	}
+
+	if p.ClientType != nil {
+		if *p.ClientType != ClientTypeConfidential && *p.ClientType != ClientTypePublic {
+			return errors.InvalidParam("ClientType未知的类型")
+		}
+
+		dbClient.ClientType = *p.ClientType
+	}
+
+	if p.GrantTypes != nil {
+		grantTypes := normalizeScope(strings.Fields(*p.GrantTypes))
+		for _, v := range grantTypes {
+			if !containsString(s.grantTypes(), v) {
+				return errors.InvalidParam("GrantType未知的类型:" + v)
+			}
+		}
+
+		dbClient.GrantTypes = strings.Join(grantTypes, " ")
+	}
+
+	if p.DefaultScope != nil {
+		dbClient.DefaultScope = *p.DefaultScope
+	}
+
+	// the default must stay inside the allowed scopes, whichever of the two changed
+	defaultScopes, err := s.checkScope(ctx, dbClient.DefaultScope)
+	if err != nil {
+		return err
+	}
+
+	allowedScopes := parseScope(dbClient.OauthScope)
+	if len(allowedScopes) > 0 && !scopeAllowed(defaultScopes, allowedScopes) {
+		return errors.InvalidParam("DefaultScope超出了Client允许的Scope")
+	}
+
+	dbClient.DefaultScope = strings.Join(defaultScopes, " ")
+
+	if p.AccessTokenTTL != nil {
+		if *p.AccessTokenTTL < 0 {
+			return errors.InvalidParam("无效的AccessTokenTTL")
+		}
+
+		dbClient.AccessTokenTtl = *p.AccessTokenTTL
+	}
+
+	if p.RefreshTokenTTL != nil {
+		if *p.RefreshTokenTTL < 0 {
+			return errors.InvalidParam("无效的RefreshTokenTTL")
+		}
+
+		dbClient.RefreshTokenTtl = *p.RefreshTokenTTL
+	}
+
+	if p.IssueRefreshToken != nil {
+		dbClient.IssueRefreshToken = 0
+		if *p.IssueRefreshToken {
+			dbClient.IssueRefreshToken = 1
+		}
+	}
+
+	if p.TokenEndpointAuthMethod != nil {
+		if !containsString(s.clientAuthMethods(), *p.TokenEndpointAuthMethod) {
+			return errors.InvalidParam("TokenEndpointAuthMethod未知的类型")
+		}
+
+		dbClient.TokenEndpointAuthMethod = *p.TokenEndpointAuthMethod
+	}
+
+	if p.Jwks != nil {
+		if *p.Jwks != "" {
+			_, err = parseClientKeys(*p.Jwks)
+			if err != nil {
+				return errors.InvalidParam("无效的Jwks:" + err.Error())
+			}
+		}
+
+		dbClient.Jwks = *p.Jwks
+	}
+
+	// a secret left behind would silently work again once the client is switched
+	// back, RotateClientSecret issues a new one instead
+	if !usesClientSecret(dbClient.TokenEndpointAuthMethod) {
+		dbClient.PasswordHash = ""
+	}
+
+	if p.TlsClientAuthSubjectDn != nil {
+		dbClient.TlsClientAuthSubjectDn = *p.TlsClientAuthSubjectDn
+	}
+
+	if dbClient.TokenEndpointAuthMethod == ClientAuthMethodPrivateKeyJwt && dbClient.Jwks == "" {
+		return errors.InvalidParam("private_key_jwt需要注册Jwks")
+	}
+
+	if dbClient.TokenEndpointAuthMethod == ClientAuthMethodSelfSignedTlsClientAuth && dbClient.Jwks == "" {
+		return errors.InvalidParam("self_signed_tls_client_auth需要注册Jwks")
+	}
+
+	if dbClient.TokenEndpointAuthMethod == ClientAuthMethodTlsClientAuth && dbClient.TlsClientAuthSubjectDn == "" {
+		return errors.InvalidParam("tls_client_auth需要注册TlsClientAuthSubjectDn")
+	}
+
+	// without a secret a client cannot keep anything confidential
+	if dbClient.TokenEndpointAuthMethod == ClientAuthMethodNone && dbClient.ClientType != ClientTypePublic {
+		return errors.InvalidParam("只有公开Client可以使用none认证")
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"crypto/subtle"
+	"golang.org/x/crypto/bcrypt"
+	"strings"
+)
+
+// Client secrets are stored in modular crypt format, so the prefix identifies the
+// algorithm and its version. Rows without a known prefix predate hashing and hold
+// the plaintext secret.
+const clientSecretBcryptPrefix = "$2"
+
+const clientSecretBcryptCost = bcrypt.DefaultCost
+
+func hashClientSecret(secret string) (hash string, err error) {
+	b, err := bcrypt.GenerateFromPassword([]byte(secret), clientSecretBcryptCost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// usesClientSecret reports whether clients of an authentication method have a secret.
+func usesClientSecret(method string) bool {
+	return method == ClientAuthMethodSecretBasic || method == ClientAuthMethodSecretPost
+}
+
+// verifyClientSecret checks secret against the stored hash and reports whether the
+// stored value should be replaced with a fresh hash. A client without a stored
+// secret never matches.
+func verifyClientSecret(hash string, secret string) (ok bool, needsRehash bool) {
+	if hash == "" {
+		return false, false
+	}
+
+	if strings.HasPrefix(hash, clientSecretBcryptPrefix) {
+		if bcrypt.CompareHashAndPassword([]byte(hash), []byte(secret)) != nil {
+			return false, false
+		}
+
+		cost, err := bcrypt.Cost([]byte(hash))
+		return true, err != nil || cost < clientSecretBcryptCost
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(secret)) != 1 {
+		return false, false
+	}
+
+	return true, true
+}
@@ -0,0 +1,13 @@
+package services
+
+import (
+	"time"
+)
+
+// isExpired reports whether a code or token expiring at the unix time expireTime
+// has expired, tolerating the configured clock skew. Expiry is written by us as a
+// unix time rather than derived from create_time, which MySQL fills in using the
+// session time zone.
+func (s *OauthService) isExpired(expireTime int64) bool {
+	return time.Now().Add(-s.options.ClockSkew).Unix() > expireTime
+}
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"github.com/dgrijalva/jwt-go"
+	"time"
+)
+
+type idTokenClaims struct {
+	jwt.StandardClaims
+	Nonce    string `json:"nonce,omitempty"`
+	AuthTime int64  `json:"auth_time,omitempty"`
+	AtHash   string `json:"at_hash,omitempty"`
+}
+
+// signIdToken builds the OIDC Core §2 ID Token returned alongside an access token.
+func (s *OauthService) signIdToken(clientId string, accountId string, nonce string, authTime int64, accessToken string) (idToken string, err error) {
+	if s.signingMethod == nil {
+		return "", NewOauthError(ErrorInvalidScope, "openid is not supported without a signing key")
+	}
+
+	now := time.Now()
+	claims := &idTokenClaims{}
+	claims.Issuer = s.options.Issuer
+	claims.Subject = accountId
+	claims.Audience = clientId
+	claims.IssuedAt = now.Unix()
+	claims.ExpiresAt = now.Add(s.options.AccessTokenLifetime).Unix()
+	claims.Nonce = nonce
+	claims.AuthTime = authTime
+	claims.AtHash = s.tokenHash(accessToken)
+
+	t := jwt.NewWithClaims(s.signingMethod, claims)
+	t.Header["kid"] = s.options.SigningKeyId
+
+	return t.SignedString(s.options.SigningKey)
+}
+
+// tokenHash computes at_hash (OIDC Core §3.1.3.6), the left half of the token's
+// hash under the hash function of the signing algorithm.
+func (s *OauthService) tokenHash(token string) string {
+	h := sha256.New()
+	if s.signingMethod == SigningMethodEdDSA {
+		h = sha512.New()
+	}
+
+	h.Write([]byte(token))
+	sum := h.Sum(nil)
+
+	return base64.RawURLEncoding.EncodeToString(sum[:len(sum)/2])
+}
@@ -0,0 +1,54 @@
+package services
+
+const (
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeRefreshToken      = "refresh_token"
+	GrantTypeClientCredentials = "client_credentials"
+)
+
+const ResponseTypeCode = "code"
+
+const (
+	ClientAuthMethodSecretBasic = "client_secret_basic"
+	ClientAuthMethodSecretPost  = "client_secret_post"
+	// ClientAuthMethodPrivateKeyJwt authenticates with a signed assertion (RFC 7523 §2.2).
+	ClientAuthMethodPrivateKeyJwt = "private_key_jwt"
+	// ClientAuthMethodTlsClientAuth authenticates with a CA issued certificate
+	// carrying the registered subject DN (RFC 8705 §2.1).
+	ClientAuthMethodTlsClientAuth = "tls_client_auth"
+	// ClientAuthMethodSelfSignedTlsClientAuth authenticates with a certificate
+	// whose key is in the client's registered JWK set (RFC 8705 §2.2).
+	ClientAuthMethodSelfSignedTlsClientAuth = "self_signed_tls_client_auth"
+	// ClientAuthMethodNone identifies a public client by client_id alone.
+	ClientAuthMethodNone = "none"
+)
+
+// grantTypes lists the grants /token accepts.
+func (s *OauthService) grantTypes() []string {
+	return []string{GrantTypeAuthorizationCode, GrantTypeRefreshToken, GrantTypeClientCredentials}
+}
+
+// clientAuthMethods lists how clients may authenticate to /token and /revoke.
+func (s *OauthService) clientAuthMethods() []string {
+	return append(s.introspectionAuthMethods(), ClientAuthMethodNone)
+}
+
+// introspectionAuthMethods leaves out none, introspection is for resource servers
+// and would otherwise let anyone probe tokens. The certificate methods depend on
+// certificates reaching us, and tls_client_auth on CAs to verify them with.
+func (s *OauthService) introspectionAuthMethods() []string {
+	methods := []string{ClientAuthMethodSecretBasic, ClientAuthMethodSecretPost, ClientAuthMethodPrivateKeyJwt}
+	if s.options.MtlsEnabled {
+		if s.options.MtlsClientCAs != nil {
+			methods = append(methods, ClientAuthMethodTlsClientAuth)
+		}
+		methods = append(methods, ClientAuthMethodSelfSignedTlsClientAuth)
+	}
+
+	return methods
+}
+
+// supportedClaims lists the claims signIdToken and Me may return.
+func (s *OauthService) supportedClaims() []string {
+	return []string{"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce", "at_hash"}
+}
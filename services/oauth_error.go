@@ -0,0 +1,49 @@
+package services
+
+import (
+	"net/http"
+)
+
+// Error codes of RFC 6749 §5.2, shared by the revocation (RFC 7009 §2.2.1) and
+// introspection (RFC 7662 §2.3) endpoints, and those of RFC 6750 §3.1 for
+// requests made with an access token.
+const (
+	ErrorInvalidRequest       = "invalid_request"
+	ErrorInvalidClient        = "invalid_client"
+	ErrorInvalidGrant         = "invalid_grant"
+	ErrorUnauthorizedClient   = "unauthorized_client"
+	ErrorUnsupportedGrantType = "unsupported_grant_type"
+	ErrorInvalidScope         = "invalid_scope"
+	ErrorServerError          = "server_error"
+	ErrorInvalidToken         = "invalid_token"
+	ErrorInsufficientScope    = "insufficient_scope"
+)
+
+// OauthError is an error a client is expected to act on. Its description is sent
+// as error_description and must stay within printable ASCII.
+type OauthError struct {
+	Code        string
+	Description string
+}
+
+func NewOauthError(code string, description string) *OauthError {
+	return &OauthError{Code: code, Description: description}
+}
+
+func (e *OauthError) Error() string {
+	return e.Code + ": " + e.Description
+}
+
+// Status is the HTTP status the error is returned with.
+func (e *OauthError) Status() int {
+	switch e.Code {
+	case ErrorInvalidClient, ErrorInvalidToken:
+		return http.StatusUnauthorized
+	case ErrorInsufficientScope:
+		return http.StatusForbidden
+	case ErrorServerError:
+		return http.StatusInternalServerError
+	default:
+		return http.StatusBadRequest
+	}
+}
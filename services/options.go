@@ -0,0 +1,104 @@
+package services
+
+import (
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+)
+
+// NewOauthServiceOptionsFromEnv builds the service options from environment
+// variables, leaving anything unset at its default.
+func NewOauthServiceOptionsFromEnv() (options *OauthServiceOptions, err error) {
+	options = &OauthServiceOptions{}
+
+	options.ClockSkew, err = durationFromEnv("OAUTH_CLOCK_SKEW", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	options.AuthorizationCodeLifetime, err = durationFromEnv("OAUTH_AUTHORIZATION_CODE_LIFETIME", 5*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+
+	options.AccessTokenLifetime, err = durationFromEnv("OAUTH_ACCESS_TOKEN_LIFETIME", time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	options.RefreshTokenIdleTimeout, err = durationFromEnv("OAUTH_REFRESH_TOKEN_IDLE_TIMEOUT", 14*24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	options.RefreshTokenMaxLifetime, err = durationFromEnv("OAUTH_REFRESH_TOKEN_MAX_LIFETIME", 90*24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	options.AccessTokenFormat = os.Getenv("OAUTH_ACCESS_TOKEN_FORMAT")
+	options.Issuer = os.Getenv("OAUTH_ISSUER")
+	options.AuthorizationEndpoint = os.Getenv("OAUTH_AUTHORIZATION_ENDPOINT")
+	options.AccessTokenAudience = os.Getenv("OAUTH_ACCESS_TOKEN_AUDIENCE")
+	options.SigningKeyId = os.Getenv("OAUTH_SIGNING_KEY_ID")
+	options.AllowAccessTokenQuery = os.Getenv("OAUTH_ALLOW_ACCESS_TOKEN_QUERY") == "true"
+	options.ClientCertificateHeader = os.Getenv("OAUTH_MTLS_CLIENT_CERT_HEADER")
+	options.MtlsEnabled = options.ClientCertificateHeader != ""
+
+	if v := os.Getenv("OAUTH_SIGNING_KEY_FILE"); v != "" {
+		options.SigningKey, err = loadSigningKey(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if v := os.Getenv("OAUTH_MTLS_CA_FILE"); v != "" {
+		options.MtlsClientCAs, err = loadCertPool(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	verifier, err := accountTokenVerifierFromEnv(options.ClockSkew)
+	if err != nil {
+		return nil, err
+	}
+
+	// a nil *JwtAccountTokenVerifier must not become a non-nil interface
+	if verifier != nil {
+		options.AccountTokenVerifier = verifier
+	}
+
+	return options, nil
+}
+
+// loadCertPool reads the PEM CA certificates trusted for tls_client_auth.
+func loadCertPool(path string) (pool *x509.CertPool, err error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	pool = x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("%s: no PEM certificates", path)
+	}
+
+	return pool, nil
+}
+
+func durationFromEnv(name string, defaultValue time.Duration) (time.Duration, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return defaultValue, nil
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %v", name, err)
+	}
+
+	return d, nil
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"github.com/NeuronFramework/errors"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// parseRedirectUris splits the whitespace-delimited redirect URIs registered for a client.
+func parseRedirectUris(redirectUris string) []string {
+	return strings.Fields(redirectUris)
+}
+
+// checkRedirectUris validates URIs being registered for a client, they must be
+// absolute and carry no fragment (RFC 6749 §3.1.2).
+func checkRedirectUris(redirectUris string) (list []string, err error) {
+	list = parseRedirectUris(redirectUris)
+	for _, v := range list {
+		u, err := url.Parse(v)
+		if err != nil || !u.IsAbs() || u.Fragment != "" {
+			return nil, errors.InvalidParam("无效的RedirectURI:" + v)
+		}
+	}
+
+	return list, nil
+}
+
+// redirectUriAllowed reports whether redirectUri matches one of the registered URIs.
+// Matching is exact, except that http loopback redirects may use any port (RFC 8252 §7.3).
+func redirectUriAllowed(registered []string, redirectUri string) bool {
+	for _, v := range registered {
+		if v == redirectUri {
+			return true
+		}
+
+		if loopbackRedirectUriMatch(v, redirectUri) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func loopbackRedirectUriMatch(registered string, redirectUri string) bool {
+	r, err := url.Parse(registered)
+	if err != nil {
+		return false
+	}
+
+	u, err := url.Parse(redirectUri)
+	if err != nil {
+		return false
+	}
+
+	if r.Scheme != "http" || u.Scheme != "http" {
+		return false
+	}
+
+	ip := net.ParseIP(r.Hostname())
+	if ip == nil || !ip.IsLoopback() {
+		return false
+	}
+
+	return r.Hostname() == u.Hostname() &&
+		r.User == nil && u.User == nil &&
+		r.Path == u.Path &&
+		r.RawQuery == u.RawQuery &&
+		u.Fragment == ""
+}
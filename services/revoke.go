@@ -0,0 +1,132 @@
+package services
+
+import (
+	"github.com/NeuronFramework/restful"
+	"github.com/NeuronOauth/oauth/storages/oauth_db"
+)
+
+// revokeTokensByAuthorizationCode deletes every access and refresh token issued
+// from the given authorization code.
+func (s *OauthService) revokeTokensByAuthorizationCode(ctx *restful.Context, authorizationCode string) (err error) {
+	dbAccessTokens, err := s.oauthDB.AccessToken.GetQuery().
+		AuthorizationCode_Equal(authorizationCode).
+		QueryList(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range dbAccessTokens {
+		err = s.oauthDB.AccessToken.Delete(ctx, nil, v.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	dbRefreshTokens, err := s.oauthDB.RefreshToken.GetQuery().
+		AuthorizationCode_Equal(authorizationCode).
+		QueryList(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range dbRefreshTokens {
+		err = s.oauthDB.RefreshToken.Delete(ctx, nil, v.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// revokeTokensByClient deletes every authorization code, access and refresh token
+// issued to the given client.
+func (s *OauthService) revokeTokensByClient(ctx *restful.Context, clientId string) (err error) {
+	dbAuthorizationCodes, err := s.oauthDB.AuthorizationCode.GetQuery().
+		ClientId_Equal(clientId).
+		QueryList(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range dbAuthorizationCodes {
+		err = s.oauthDB.AuthorizationCode.Delete(ctx, nil, v.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	dbAccessTokens, err := s.oauthDB.AccessToken.GetQuery().
+		ClientId_Equal(clientId).
+		QueryList(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range dbAccessTokens {
+		err = s.oauthDB.AccessToken.Delete(ctx, nil, v.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	dbRefreshTokens, err := s.oauthDB.RefreshToken.GetQuery().
+		ClientId_Equal(clientId).
+		QueryList(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range dbRefreshTokens {
+		err = s.oauthDB.RefreshToken.Delete(ctx, nil, v.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// revokeRefreshTokenFamily deletes every refresh token rotated from the same
+// original grant, together with the access tokens minted alongside them.
+func (s *OauthService) revokeRefreshTokenFamily(ctx *restful.Context, familyId string) (err error) {
+	dbRefreshTokens, err := s.oauthDB.RefreshToken.GetQuery().
+		FamilyId_Equal(familyId).
+		QueryList(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range dbRefreshTokens {
+		err = s.deleteRefreshToken(ctx, v)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteRefreshToken deletes a refresh token and the access tokens minted with it.
+func (s *OauthService) deleteRefreshToken(ctx *restful.Context, dbRefreshToken *oauth_db.RefreshToken) (err error) {
+	err = s.oauthDB.RefreshToken.Delete(ctx, nil, dbRefreshToken.Id)
+	if err != nil {
+		return err
+	}
+
+	dbAccessTokens, err := s.oauthDB.AccessToken.GetQuery().
+		RefreshToken_Equal(dbRefreshToken.RefreshToken).
+		QueryList(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range dbAccessTokens {
+		err = s.oauthDB.AccessToken.Delete(ctx, nil, v.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"github.com/NeuronFramework/errors"
+	"github.com/NeuronFramework/restful"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ScopeOpenId marks an OpenID Connect request (OIDC Core §3.1.2.1).
+const ScopeOpenId = "openid"
+
+// RFC 6749 §3.3, scope-token = 1*( %x21 / %x23-5B / %x5D-7E )
+var scopeTokenRegexp = regexp.MustCompile(`^[\x21\x23-\x5B\x5D-\x7E]+$`)
+
+// parseScope splits a space-delimited scope string (RFC 6749 §3.3).
+func parseScope(scope string) []string {
+	return strings.Fields(scope)
+}
+
+// scopeAllowed reports whether every scope in requested is contained in allowed.
+func scopeAllowed(requested []string, allowed []string) bool {
+	allowedSet := make(map[string]bool, len(allowed))
+	for _, v := range allowed {
+		allowedSet[v] = true
+	}
+
+	for _, v := range requested {
+		if !allowedSet[v] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeScope dedupes and sorts scopes so equal grants are stored identically.
+func normalizeScope(scopes []string) []string {
+	set := make(map[string]bool, len(scopes))
+	r := make([]string, 0, len(scopes))
+	for _, v := range scopes {
+		if !set[v] {
+			set[v] = true
+			r = append(r, v)
+		}
+	}
+
+	sort.Strings(r)
+	return r
+}
+
+// checkScope parses a requested scope string and rejects scopes that are not known.
+func (s *OauthService) checkScope(ctx *restful.Context, scope string) (scopes []string, err error) {
+	scopes = normalizeScope(parseScope(scope))
+	if len(scopes) == 0 {
+		return scopes, nil
+	}
+
+	known, err := s.knownScopes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range scopes {
+		if !known[v] {
+			return nil, errors.InvalidParam("无效的Scope:" + v)
+		}
+	}
+
+	return scopes, nil
+}
+
+// knownScopes returns the scopes registered in oauth_scope. openid is implied
+// once id_tokens can be signed and unknown without a signing key, whatever the
+// table says.
+func (s *OauthService) knownScopes(ctx *restful.Context) (known map[string]bool, err error) {
+	dbScopes, err := s.oauthDB.OauthScope.GetQuery().QueryList(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	known = make(map[string]bool, len(dbScopes)+1)
+	for _, v := range dbScopes {
+		known[v.OauthScope] = true
+	}
+	known[ScopeOpenId] = s.signingMethod != nil
+
+	return known, nil
+}
@@ -1,24 +1,114 @@
 package services
 
 import (
+	"crypto"
+	"crypto/x509"
+	"fmt"
 	"github.com/NeuronFramework/log"
 	"github.com/NeuronOauth/oauth/storages/oauth_db"
+	"github.com/dgrijalva/jwt-go"
 	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	AccessTokenFormatOpaque = "opaque"
+	AccessTokenFormatJwt    = "jwt"
 )
 
 type OauthServiceOptions struct {
+	// ClockSkew is the leeway granted when checking token expiry.
+	ClockSkew time.Duration
+	// AuthorizationCodeLifetime bounds how long a code may wait to be redeemed.
+	AuthorizationCodeLifetime time.Duration
+	// AccessTokenLifetime is the lifetime of access tokens and id_tokens.
+	AccessTokenLifetime time.Duration
+	// RefreshTokenIdleTimeout is the sliding lifetime of a refresh token, every
+	// rotation starts it again.
+	RefreshTokenIdleTimeout time.Duration
+	// RefreshTokenMaxLifetime caps the session a refresh token family represents,
+	// counted from the original grant and unaffected by rotation. Zero means no cap.
+	RefreshTokenMaxLifetime time.Duration
+	// AccessTokenFormat is either opaque (the default) or jwt.
+	AccessTokenFormat string
+	// Issuer is the iss claim of signed tokens and the public base URL of the oauth-api.
+	Issuer string
+	// AuthorizationEndpoint is the public URL of /authorize, the discovery documents
+	// are not served without it.
+	AuthorizationEndpoint string
+	// AccessTokenAudience is the aud claim of JWT access tokens, Issuer when empty.
+	AccessTokenAudience string
+	// SigningKey signs tokens, its public half is published at /.well-known/jwks.json.
+	SigningKey crypto.Signer
+	// SigningKeyId is the kid of SigningKey, its RFC 7638 thumbprint when empty.
+	SigningKeyId string
+	// AllowAccessTokenQuery accepts access tokens in the query string (RFC 6750 §2.3),
+	// where proxies and access logs record them.
+	AllowAccessTokenQuery bool
+	// AccountTokenVerifier checks the accountJwt presented to /authorize.
+	AccountTokenVerifier AccountTokenVerifier
+	// MtlsClientCAs verifies the certificates of tls_client_auth clients, which
+	// are rejected when it is nil.
+	MtlsClientCAs *x509.CertPool
+	// MtlsEnabled is set when client certificates reach the oauth-api through
+	// ClientCertificateHeader, restful.Run offers no way to request them over TLS.
+	// It enables the tls_client_auth (with MtlsClientCAs) and
+	// self_signed_tls_client_auth methods and with them certificate-bound tokens.
+	MtlsEnabled bool
+	// ClientCertificateHeader names the header a TLS terminating proxy forwards the
+	// client certificate in, as URL-escaped PEM (nginx's $ssl_client_escaped_cert).
+	// The proxy must set or clear it on every request, since it is trusted as is.
+	ClientCertificateHeader string
 }
 
 type OauthService struct {
-	logger  *zap.Logger
-	options *OauthServiceOptions
-	oauthDB *oauth_db.DB
+	logger        *zap.Logger
+	options       *OauthServiceOptions
+	oauthDB       *oauth_db.DB
+	signingMethod jwt.SigningMethod
 }
 
 func NewOauthService(options *OauthServiceOptions) (s *OauthService, err error) {
 	s = &OauthService{}
 	s.logger = log.TypedLogger(s)
 	s.options = options
+
+	if options.AccessTokenFormat == "" {
+		options.AccessTokenFormat = AccessTokenFormatOpaque
+	}
+	if options.AccessTokenFormat != AccessTokenFormatOpaque && options.AccessTokenFormat != AccessTokenFormatJwt {
+		return nil, fmt.Errorf("unknown access token format %s", options.AccessTokenFormat)
+	}
+
+	if options.AuthorizationCodeLifetime <= 0 || options.AccessTokenLifetime <= 0 || options.RefreshTokenIdleTimeout <= 0 {
+		return nil, fmt.Errorf("token lifetimes must be positive")
+	}
+
+	if options.SigningKey != nil {
+		s.signingMethod, err = signingMethodForKey(options.SigningKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if options.SigningKeyId == "" {
+			options.SigningKeyId = jwkThumbprint(publicJwk(options.SigningKey, "", s.signingMethod.Alg()))
+		}
+	}
+
+	if options.AccessTokenFormat == AccessTokenFormatJwt {
+		if options.SigningKey == nil {
+			return nil, fmt.Errorf("jwt access tokens need a signing key")
+		}
+
+		if options.Issuer == "" {
+			return nil, fmt.Errorf("jwt access tokens need an issuer")
+		}
+
+		if options.AccessTokenAudience == "" {
+			options.AccessTokenAudience = options.Issuer
+		}
+	}
+
 	s.oauthDB, err = oauth_db.NewDB()
 	if err != nil {
 		return nil, err
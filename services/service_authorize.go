@@ -1,30 +1,77 @@
 package services
 
 import (
+	"fmt"
+	"github.com/NeuronFramework/errors"
 	"github.com/NeuronFramework/rand"
 	"github.com/NeuronFramework/restful"
 	"github.com/NeuronOauth/oauth/models"
 	"github.com/NeuronOauth/oauth/storages/oauth_db"
-	"github.com/dgrijalva/jwt-go"
+	"strings"
+	"time"
 )
 
 func (s *OauthService) Authorize(ctx *restful.Context, p *models.AuthorizeParams) (code *models.AuthorizationCode, err error) {
-	claims := jwt.StandardClaims{}
-	_, err = jwt.ParseWithClaims(p.AccountJwt, &claims, func(t *jwt.Token) (interface{}, error) {
-		return []byte("0123456789"), nil
-	})
+	if s.options.AccountTokenVerifier == nil {
+		return nil, fmt.Errorf("no account token verifier configured")
+	}
+
+	account, err := s.options.AccountTokenVerifier.Verify(p.AccountJwt)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.ResponseType != ResponseTypeCode {
+		return nil, errors.InvalidParam("ResponseType未知的类型")
+	}
+
+	dbClient, err := s.oauthDB.OauthClient.GetQuery().ClientId_Equal(p.ClientID).QueryOne(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if dbClient == nil {
+		return nil, errors.InvalidParam("ClientID不存在")
+	}
+
+	if !redirectUriAllowed(parseRedirectUris(dbClient.RedirectUri), p.RedirectURI) {
+		return nil, errors.InvalidParam("RedirectURI未注册")
+	}
+
+	client := oauth_db.FromOauthClient(dbClient)
+	err = s.checkClientGrant(client, GrantTypeAuthorizationCode)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes, err := s.resolveClientScope(ctx, client, GrantTypeAuthorizationCode, p.Scope)
 	if err != nil {
 		return nil, err
 	}
 
+	codeChallengeMethod, err := checkCodeChallenge(p.CodeChallenge, p.CodeChallengeMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	// public clients cannot keep a secret, PKCE is what binds the code to them
+	if client.ClientType == ClientTypePublic && p.CodeChallenge == "" {
+		return nil, errors.InvalidParam("公开Client必须使用PKCE")
+	}
+
 	dbAuthorizationCode := &oauth_db.AuthorizationCode{}
 	dbAuthorizationCode.AuthorizationCode = rand.NextHex(16)
 	dbAuthorizationCode.ClientId = p.ClientID
-	dbAuthorizationCode.AccountId = claims.Subject
+	dbAuthorizationCode.AccountId = account.AccountId
 	dbAuthorizationCode.RedirectUri = p.RedirectURI
-	dbAuthorizationCode.OauthScope = p.Scope
-	dbAuthorizationCode.ExpireSeconds = 300
+	dbAuthorizationCode.OauthScope = strings.Join(scopes, " ")
+	dbAuthorizationCode.ExpireSeconds = int64(s.options.AuthorizationCodeLifetime / time.Second)
+	dbAuthorizationCode.ExpireTime = time.Now().Unix() + dbAuthorizationCode.ExpireSeconds
 	dbAuthorizationCode.UserAgent = ctx.UserAgent
+	dbAuthorizationCode.CodeChallenge = p.CodeChallenge
+	dbAuthorizationCode.CodeChallengeMethod = codeChallengeMethod
+	dbAuthorizationCode.Nonce = p.Nonce
+	dbAuthorizationCode.AuthTime = account.AuthTime
 	_, err = s.oauthDB.AuthorizationCode.Insert(ctx, nil, dbAuthorizationCode)
 	if err != nil {
 		return nil, err
@@ -1,22 +1,107 @@
 package services
 
 import (
-	"github.com/NeuronFramework/errors"
 	"github.com/NeuronFramework/restful"
+	"github.com/NeuronFramework/sql/wrap"
 	"github.com/NeuronOauth/oauth/models"
+	"go.uber.org/zap"
 )
 
-func (s *OauthService) AuthorizeCodeGrant(ctx *restful.Context, authorizationCode string, redirectUri string, clientId string, oAuth2Client *models.OauthClient) (accessToken *models.AccessToken, err error) {
-	dbAuthorizationCode, err := s.oauthDB.AuthorizationCode.GetQuery().
-		AuthorizationCode_Equal(authorizationCode).
-		QueryOne(ctx, nil)
+func (s *OauthService) AuthorizeCodeGrant(ctx *restful.Context, authorizationCode string, redirectUri string, clientId string, codeVerifier string, oAuth2Client *models.OauthClient) (accessToken *models.AccessToken, err error) {
+	if clientId != oAuth2Client.ClientId {
+		return nil, NewOauthError(ErrorInvalidRequest, "client_id does not match the authenticated client")
+	}
+
+	err = s.checkClientGrant(oAuth2Client, GrantTypeAuthorizationCode)
+	if err != nil {
+		return nil, err
+	}
+
+	replayed := false
+	err = s.oauthDB.TransactionReadCommitted(ctx, func(tx *wrap.Tx) (err error) {
+		dbAuthorizationCode, err := s.oauthDB.AuthorizationCode.GetQuery().
+			AuthorizationCode_Equal(authorizationCode).
+			ForUpdate().
+			QueryOne(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		if dbAuthorizationCode == nil {
+			return NewOauthError(ErrorInvalidGrant, "invalid authorization code")
+		}
+
+		// codes are bound to the client they were issued to
+		if dbAuthorizationCode.ClientId != oAuth2Client.ClientId {
+			return NewOauthError(ErrorInvalidGrant, "invalid authorization code")
+		}
+
+		// the row lock serializes concurrent redemptions, only the first one sees it unused
+		if dbAuthorizationCode.Used != 0 {
+			replayed = true
+			return nil
+		}
+
+		if s.isExpired(dbAuthorizationCode.ExpireTime) {
+			return NewOauthError(ErrorInvalidGrant, "authorization code expired")
+		}
+
+		// RFC 6749 §4.1.3, redirect_uri must be identical to the one used in the authorization request
+		if dbAuthorizationCode.RedirectUri != redirectUri {
+			return NewOauthError(ErrorInvalidGrant, "redirect_uri does not match the authorization request")
+		}
+
+		// a none client proves nothing but its client_id, only PKCE ties the code to
+		// it, whatever the client was registered as when the code was issued
+		if oAuth2Client.TokenEndpointAuthMethod == ClientAuthMethodNone && dbAuthorizationCode.CodeChallenge == "" {
+			return NewOauthError(ErrorInvalidGrant, "authorization code was issued without PKCE")
+		}
+
+		if !verifyCodeVerifier(dbAuthorizationCode.CodeChallenge, dbAuthorizationCode.CodeChallengeMethod, codeVerifier) {
+			return NewOauthError(ErrorInvalidGrant, "invalid code_verifier")
+		}
+
+		dbAuthorizationCode.Used = 1
+		err = s.oauthDB.AuthorizationCode.Update(ctx, tx, dbAuthorizationCode)
+		if err != nil {
+			return err
+		}
+
+		accessToken, err = s.newAccessToken(ctx, tx, &tokenGrant{
+			Client:            oAuth2Client,
+			AccountId:         dbAuthorizationCode.AccountId,
+			Scope:             dbAuthorizationCode.OauthScope,
+			AuthorizationCode: authorizationCode,
+			IssueRefreshToken: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		if containsString(parseScope(dbAuthorizationCode.OauthScope), ScopeOpenId) {
+			accessToken.IdToken, err = s.signIdToken(dbAuthorizationCode.ClientId, dbAuthorizationCode.AccountId,
+				dbAuthorizationCode.Nonce, dbAuthorizationCode.AuthTime, accessToken.AccessToken)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if dbAuthorizationCode == nil {
-		return nil, errors.InvalidParam("无效的AuthorizationCode")
+	if replayed {
+		// RFC 6749 §4.1.2, a code used more than once revokes every token issued from it
+		s.logger.Warn("AuthorizationCode replayed", zap.String("clientId", clientId))
+		err = s.revokeTokensByAuthorizationCode(ctx, authorizationCode)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, NewOauthError(ErrorInvalidGrant, "invalid authorization code")
 	}
 
-	return s.newAccessToken(ctx, dbAuthorizationCode.ClientId, dbAuthorizationCode.AccountId, dbAuthorizationCode.OauthScope)
+	return accessToken, nil
 }
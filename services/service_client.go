@@ -1,25 +1,73 @@
 package services
 
 import (
-	"fmt"
 	"github.com/NeuronFramework/restful"
+	"github.com/NeuronFramework/sql/wrap"
 	"github.com/NeuronOauth/oauth/models"
 	"github.com/NeuronOauth/oauth/storages/oauth_db"
+	"go.uber.org/zap"
 )
 
-func (s *OauthService) ClientLogin(ctx *restful.Context, clientId string, password string) (c *models.OauthClient, err error) {
+// AuthenticateClient authenticates a client to /token, /revoke or /introspect.
+// method is how the credentials were presented and must be the method the client
+// is registered for, a none client has no secret to check.
+func (s *OauthService) AuthenticateClient(ctx *restful.Context, method string, clientId string, clientSecret string) (c *models.OauthClient, err error) {
 	dbClient, err := s.oauthDB.OauthClient.GetQuery().ClientId_Equal(clientId).QueryOne(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	if dbClient == nil {
-		return nil, fmt.Errorf("clientId不存在")
+		return nil, NewOauthError(ErrorInvalidClient, "client authentication failed")
 	}
 
-	if dbClient.PasswordHash != password {
-		return nil, fmt.Errorf("password错误")
+	if dbClient.TokenEndpointAuthMethod != method {
+		return nil, NewOauthError(ErrorInvalidClient, "client authentication method not allowed for this client")
+	}
+
+	if method == ClientAuthMethodNone {
+		return oauth_db.FromOauthClient(dbClient), nil
+	}
+
+	ok, needsRehash := verifyClientSecret(dbClient.PasswordHash, clientSecret)
+	if !ok {
+		return nil, NewOauthError(ErrorInvalidClient, "client authentication failed")
+	}
+
+	if needsRehash {
+		s.upgradeClientSecret(ctx, dbClient, clientSecret)
 	}
 
 	return oauth_db.FromOauthClient(dbClient), nil
 }
+
+// upgradeClientSecret re-hashes a legacy or weakly hashed secret after a successful
+// login. Failures are logged only, the login itself has already succeeded.
+func (s *OauthService) upgradeClientSecret(ctx *restful.Context, dbClient *oauth_db.OauthClient, clientSecret string) {
+	hash, err := hashClientSecret(clientSecret)
+	if err != nil {
+		s.logger.Error("hashClientSecret", zap.Error(err))
+		return
+	}
+
+	// dbClient was read before the secret check, a rotation or update committed
+	// since must not be overwritten, so the row is re-read under lock and only
+	// upgraded while it still holds the hash that was verified
+	err = s.oauthDB.TransactionReadCommitted(ctx, func(tx *wrap.Tx) (err error) {
+		current, err := s.oauthDB.OauthClient.GetQuery().Id_Equal(dbClient.Id).ForUpdate().QueryOne(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		if current == nil || current.PasswordHash != dbClient.PasswordHash {
+			return nil
+		}
+
+		current.PasswordHash = hash
+		return s.oauthDB.OauthClient.Update(ctx, tx, current)
+	})
+	if err != nil {
+		s.logger.Error("OauthClient.Update", zap.String("clientId", dbClient.ClientId), zap.Error(err))
+		return
+	}
+}
@@ -0,0 +1,33 @@
+package services
+
+import (
+	"github.com/NeuronFramework/restful"
+	"github.com/NeuronOauth/oauth/models"
+	"strings"
+)
+
+func (s *OauthService) ClientCredentialsGrant(ctx *restful.Context, scope string, client *models.OauthClient) (accessToken *models.AccessToken, err error) {
+	err = s.checkClientGrant(client, GrantTypeClientCredentials)
+	if err != nil {
+		return nil, err
+	}
+
+	requestedScopes, err := s.resolveClientScope(ctx, client, GrantTypeClientCredentials, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	// without a default the client acts with everything it is allowed
+	if len(requestedScopes) == 0 {
+		requestedScopes = normalizeScope(parseScope(client.Scope))
+	}
+
+	if len(requestedScopes) == 0 {
+		return nil, NewOauthError(ErrorInvalidScope, "scope is required")
+	}
+
+	return s.newAccessToken(ctx, nil, &tokenGrant{
+		Client: client,
+		Scope:  strings.Join(requestedScopes, " "),
+	})
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"github.com/NeuronFramework/errors"
+	"github.com/NeuronFramework/rand"
+	"github.com/NeuronFramework/restful"
+	"github.com/NeuronOauth/oauth/models"
+	"github.com/NeuronOauth/oauth/storages/oauth_db"
+)
+
+const (
+	clientListDefaultPageSize = 20
+	clientListMaxPageSize     = 100
+)
+
+// CreateClient registers a client. Clients authenticating with a secret get one
+// generated, it is only ever returned here and by RotateClientSecret, the database
+// keeps its hash.
+func (s *OauthService) CreateClient(ctx *restful.Context, accountId string, p *models.OauthClientParams) (c *models.OauthClient, err error) {
+	if accountId == "" {
+		return nil, errors.InvalidParam("AccountID不能为空")
+	}
+
+	dbClient := &oauth_db.OauthClient{}
+	dbClient.ClientId = rand.NextHex(16)
+	dbClient.AccountId = accountId
+	dbClient.ClientType = ClientTypeConfidential
+	dbClient.IssueRefreshToken = 1
+	dbClient.TokenEndpointAuthMethod = ClientAuthMethodSecretBasic
+	err = s.applyClientParams(ctx, dbClient, p)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := ""
+	if usesClientSecret(dbClient.TokenEndpointAuthMethod) {
+		secret = rand.NextHex(32)
+		dbClient.PasswordHash, err = hashClientSecret(secret)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = s.oauthDB.OauthClient.Insert(ctx, nil, dbClient)
+	if err != nil {
+		return nil, err
+	}
+
+	c = oauth_db.FromOauthClient(dbClient)
+	c.ClientSecret = secret
+
+	return c, nil
+}
+
+func (s *OauthService) GetClient(ctx *restful.Context, clientId string) (c *models.OauthClient, err error) {
+	dbClient, err := s.getClient(ctx, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	return oauth_db.FromOauthClient(dbClient), nil
+}
+
+// ListClients pages through the clients owned by accountId, page starts at 1.
+func (s *OauthService) ListClients(ctx *restful.Context, accountId string, page int64, pageSize int64) (clients []*models.OauthClient, err error) {
+	if page < 1 {
+		page = 1
+	}
+
+	if pageSize < 1 {
+		pageSize = clientListDefaultPageSize
+	} else if pageSize > clientListMaxPageSize {
+		pageSize = clientListMaxPageSize
+	}
+
+	dbClients, err := s.oauthDB.OauthClient.GetQuery().
+		AccountId_Equal(accountId).
+		OrderBy(oauth_db.OAUTH_CLIENT_FIELD_ID, true).
+		Limit((page-1)*pageSize, pageSize).
+		QueryList(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	clients = make([]*models.OauthClient, 0, len(dbClients))
+	for _, v := range dbClients {
+		clients = append(clients, oauth_db.FromOauthClient(v))
+	}
+
+	return clients, nil
+}
+
+// UpdateClient changes the settings of a client, nil fields are left unchanged.
+func (s *OauthService) UpdateClient(ctx *restful.Context, clientId string, p *models.OauthClientParams) (c *models.OauthClient, err error) {
+	dbClient, err := s.getClient(ctx, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.applyClientParams(ctx, dbClient, p)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.oauthDB.OauthClient.Update(ctx, nil, dbClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return oauth_db.FromOauthClient(dbClient), nil
+}
+
+// RotateClientSecret replaces the secret of a client, the old one stops working immediately.
+// A client switched to a client_secret method has none until it is rotated.
+func (s *OauthService) RotateClientSecret(ctx *restful.Context, clientId string) (c *models.OauthClient, err error) {
+	dbClient, err := s.getClient(ctx, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !usesClientSecret(dbClient.TokenEndpointAuthMethod) {
+		return nil, errors.InvalidParam("该Client的认证方式不使用Secret")
+	}
+
+	secret := rand.NextHex(32)
+	dbClient.PasswordHash, err = hashClientSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.oauthDB.OauthClient.Update(ctx, nil, dbClient)
+	if err != nil {
+		return nil, err
+	}
+
+	c = oauth_db.FromOauthClient(dbClient)
+	c.ClientSecret = secret
+
+	return c, nil
+}
+
+// DeleteClient removes a client together with every code and token issued to it.
+func (s *OauthService) DeleteClient(ctx *restful.Context, clientId string) (err error) {
+	dbClient, err := s.getClient(ctx, clientId)
+	if err != nil {
+		return err
+	}
+
+	err = s.revokeTokensByClient(ctx, clientId)
+	if err != nil {
+		return err
+	}
+
+	return s.oauthDB.OauthClient.Delete(ctx, nil, dbClient.Id)
+}
+
+func (s *OauthService) getClient(ctx *restful.Context, clientId string) (dbClient *oauth_db.OauthClient, err error) {
+	dbClient, err = s.oauthDB.OauthClient.GetQuery().ClientId_Equal(clientId).QueryOne(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if dbClient == nil {
+		return nil, errors.NotFound("clientId不存在")
+	}
+
+	return dbClient, nil
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"crypto/x509"
+	"github.com/NeuronFramework/restful"
+	"github.com/NeuronOauth/oauth/models"
+)
+
+// Introspect implements RFC 7662. Any authenticated client may introspect access
+// tokens, since resource servers validate tokens that were issued to other clients.
+// Refresh tokens are only reported to the client they were issued to. A resource
+// server checks a bound token against the returned cnf itself (RFC 8705 §3.2), a
+// client presenting its own bound token must do so over the same certificate, cert
+// being the one on the connection.
+func (s *OauthService) Introspect(ctx *restful.Context, token string, tokenTypeHint string, client *models.OauthClient, cert *x509.Certificate) (result *models.TokenIntrospection, err error) {
+	if !containsString(s.introspectionAuthMethods(), client.TokenEndpointAuthMethod) {
+		return nil, NewOauthError(ErrorInvalidClient, "client authentication method not allowed for introspection")
+	}
+
+	if tokenTypeHint == TokenTypeHintRefreshToken {
+		result, err = s.introspectRefreshToken(ctx, token, client)
+		if err != nil || result != nil {
+			return result, err
+		}
+
+		result, err = s.introspectAccessToken(ctx, token)
+	} else {
+		result, err = s.introspectAccessToken(ctx, token)
+		if err != nil || result != nil {
+			return result, err
+		}
+
+		result, err = s.introspectRefreshToken(ctx, token, client)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return &models.TokenIntrospection{Active: false}, nil
+	}
+
+	if result.ClientId == client.ClientId && !checkCertificateBinding(result.CertThumbprint, cert) {
+		return &models.TokenIntrospection{Active: false}, nil
+	}
+
+	return result, nil
+}
+
+func (s *OauthService) introspectAccessToken(ctx *restful.Context, accessToken string) (result *models.TokenIntrospection, err error) {
+	dbAccessToken, err := s.getAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if dbAccessToken == nil {
+		return nil, nil
+	}
+
+	if s.isExpired(dbAccessToken.ExpireTime) {
+		return &models.TokenIntrospection{Active: false}, nil
+	}
+
+	return &models.TokenIntrospection{
+		Active:         true,
+		Scope:          dbAccessToken.OauthScope,
+		ClientId:       dbAccessToken.ClientId,
+		AccountId:      dbAccessToken.AccountId,
+		ExpiresAt:      dbAccessToken.ExpireTime,
+		IssuedAt:       dbAccessToken.IssueTime,
+		TokenType:      "bearer",
+		CertThumbprint: dbAccessToken.CnfX5tS256,
+	}, nil
+}
+
+func (s *OauthService) introspectRefreshToken(ctx *restful.Context, refreshToken string, client *models.OauthClient) (result *models.TokenIntrospection, err error) {
+	dbRefreshToken, err := s.oauthDB.RefreshToken.GetQuery().RefreshToken_Equal(refreshToken).QueryOne(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if dbRefreshToken == nil {
+		return nil, nil
+	}
+
+	// refresh tokens are only ever presented back to us by their own client, no
+	// resource server has a reason to inspect another client's
+	if dbRefreshToken.ClientId != client.ClientId {
+		return &models.TokenIntrospection{Active: false}, nil
+	}
+
+	if dbRefreshToken.Used != 0 || s.isExpired(dbRefreshToken.ExpireTime) {
+		return &models.TokenIntrospection{Active: false}, nil
+	}
+
+	return &models.TokenIntrospection{
+		Active:    true,
+		Scope:     dbRefreshToken.OauthScope,
+		ClientId:  dbRefreshToken.ClientId,
+		AccountId: dbRefreshToken.AccountId,
+		ExpiresAt: dbRefreshToken.ExpireTime,
+		IssuedAt:  dbRefreshToken.IssueTime,
+	}, nil
+}
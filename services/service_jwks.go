@@ -0,0 +1,18 @@
+package services
+
+import (
+	"github.com/NeuronFramework/restful"
+	"github.com/NeuronOauth/oauth/models"
+)
+
+// Jwks returns the public keys resource servers use to verify our tokens offline.
+func (s *OauthService) Jwks(ctx *restful.Context) (jwks *models.JSONWebKeySet, err error) {
+	jwks = &models.JSONWebKeySet{Keys: []*models.JSONWebKey{}}
+	if s.options.SigningKey == nil {
+		return jwks, nil
+	}
+
+	jwks.Keys = append(jwks.Keys, publicJwk(s.options.SigningKey, s.options.SigningKeyId, s.signingMethod.Alg()))
+
+	return jwks, nil
+}
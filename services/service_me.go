@@ -1,19 +1,35 @@
 package services
 
 import (
-	"github.com/NeuronFramework/errors"
+	"crypto/x509"
 	"github.com/NeuronFramework/restful"
+	"github.com/NeuronOauth/oauth/models"
 )
 
-func (s *OauthService) Me(ctx *restful.Context, accessToken string) (accountId string, err error) {
-	dbAccessToken, err := s.oauthDB.AccessToken.GetQuery().AccessToken_Equal(accessToken).QueryOne(ctx, nil)
+// Me returns the end-user of accessToken. cert is the client certificate of the
+// connection, nil without one, and must match a certificate-bound token.
+func (s *OauthService) Me(ctx *restful.Context, accessToken string, cert *x509.Certificate) (userInfo *models.UserInfo, err error) {
+	dbAccessToken, err := s.getAccessToken(ctx, accessToken)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	if dbAccessToken == nil {
-		return "", errors.NotFound("accessToken不存在")
+		return nil, NewOauthError(ErrorInvalidToken, "unknown access token")
 	}
 
-	return dbAccessToken.AccountId, nil
+	if s.isExpired(dbAccessToken.ExpireTime) {
+		return nil, NewOauthError(ErrorInvalidToken, "access token expired")
+	}
+
+	if !checkCertificateBinding(dbAccessToken.CnfX5tS256, cert) {
+		return nil, NewOauthError(ErrorInvalidToken, "access token is bound to a different certificate")
+	}
+
+	// the UserInfo endpoint speaks for the end-user, client_credentials tokens have none
+	if dbAccessToken.AccountId == "" {
+		return nil, NewOauthError(ErrorInvalidToken, "access token has no end-user")
+	}
+
+	return &models.UserInfo{Subject: dbAccessToken.AccountId}, nil
 }
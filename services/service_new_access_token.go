@@ -3,37 +3,115 @@ package services
 import (
 	"github.com/NeuronFramework/rand"
 	"github.com/NeuronFramework/restful"
+	"github.com/NeuronFramework/sql/wrap"
 	"github.com/NeuronOauth/oauth/models"
 	"github.com/NeuronOauth/oauth/storages/oauth_db"
+	"time"
 )
 
-func (s *OauthService) newAccessToken(ctx *restful.Context, clientId string, accountId string, scope string) (accessToken *models.AccessToken, err error) {
+// tokenGrant describes what a new access token, and optionally its refresh token, is issued for.
+type tokenGrant struct {
+	Client            *models.OauthClient
+	AccountId         string
+	Scope             string
+	AuthorizationCode string
+	IssueRefreshToken bool
+	// RefreshScope is the scope of the refresh token, Scope when empty. A refresh
+	// token keeps its original scope even when the access token is narrowed (RFC 6749 §6).
+	RefreshScope string
+	// FamilyId links a rotated refresh token to its predecessors, a new family
+	// is started when empty.
+	FamilyId string
+	// SessionExpireTime is the unix time the family's session ends, inherited on
+	// rotation. Zero starts a new session.
+	SessionExpireTime int64
+}
+
+func (s *OauthService) newAccessToken(ctx *restful.Context, tx *wrap.Tx, grant *tokenGrant) (accessToken *models.AccessToken, err error) {
+	now := time.Now().Unix()
+	refreshToken := ""
+	issueRefreshToken := grant.IssueRefreshToken && grant.Client.IssueRefreshToken
+	if issueRefreshToken {
+		refreshToken = rand.NextHex(16)
+	}
+
 	dbAccessToken := &oauth_db.AccessToken{}
 	dbAccessToken.AccessToken = rand.NextHex(16)
-	dbAccessToken.ClientId = clientId
-	dbAccessToken.AccountId = accountId
-	dbAccessToken.OauthScope = scope
-	dbAccessToken.ExpireSeconds = 300
-	_, err = s.oauthDB.AccessToken.Insert(ctx, nil, dbAccessToken)
+	dbAccessToken.Jti = rand.NextHex(16)
+	dbAccessToken.ClientId = grant.Client.ClientId
+	dbAccessToken.AccountId = grant.AccountId
+	dbAccessToken.OauthScope = grant.Scope
+	dbAccessToken.ExpireSeconds = int64(s.accessTokenLifetime(grant.Client) / time.Second)
+	dbAccessToken.IssueTime = now
+	dbAccessToken.ExpireTime = now + dbAccessToken.ExpireSeconds
+	dbAccessToken.AuthorizationCode = grant.AuthorizationCode
+	dbAccessToken.RefreshToken = refreshToken
+	// RFC 8705 §3, tokens of a client that authenticated with a certificate are bound to it
+	dbAccessToken.CnfX5tS256 = grant.Client.CertificateThumbprint
+	_, err = s.oauthDB.AccessToken.Insert(ctx, tx, dbAccessToken)
 	if err != nil {
 		return nil, err
 	}
 
+	accessToken = oauth_db.FromAccessToken(dbAccessToken)
+	accessToken.TokenType = "bearer"
+
+	if s.options.AccessTokenFormat == AccessTokenFormatJwt {
+		accessToken.AccessToken, err = s.signJwtAccessToken(dbAccessToken, grant.Client.ClientId, grant.AccountId, grant.Scope)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !issueRefreshToken {
+		return accessToken, nil
+	}
+
 	dbRefreshToken := &oauth_db.RefreshToken{}
-	dbRefreshToken.RefreshToken = rand.NextHex(16)
-	dbRefreshToken.ClientId = clientId
-	dbRefreshToken.AccountId = accountId
-	dbRefreshToken.OauthScope = scope
-	dbRefreshToken.ExpireSeconds = 300
-	_, err = s.oauthDB.RefreshToken.Insert(ctx, nil, dbRefreshToken)
+	dbRefreshToken.RefreshToken = refreshToken
+	dbRefreshToken.ClientId = grant.Client.ClientId
+	dbRefreshToken.AccountId = grant.AccountId
+	dbRefreshToken.OauthScope = grant.Scope
+	if grant.RefreshScope != "" {
+		dbRefreshToken.OauthScope = grant.RefreshScope
+	}
+	dbRefreshToken.SessionExpireTime, dbRefreshToken.ExpireSeconds = s.refreshTokenExpiry(grant.Client, grant.SessionExpireTime)
+	dbRefreshToken.IssueTime = now
+	dbRefreshToken.ExpireTime = now + dbRefreshToken.ExpireSeconds
+	dbRefreshToken.AuthorizationCode = grant.AuthorizationCode
+	dbRefreshToken.FamilyId = grant.FamilyId
+	if dbRefreshToken.FamilyId == "" {
+		dbRefreshToken.FamilyId = refreshToken
+	}
+	_, err = s.oauthDB.RefreshToken.Insert(ctx, tx, dbRefreshToken)
 	if err != nil {
 		return nil, err
 	}
 
-	accessToken = oauth_db.FromAccessToken(dbAccessToken)
 	accessToken.RefreshToken = dbRefreshToken.RefreshToken
 
-	accessToken.TokenType = "bearer"
-
 	return accessToken, err
 }
+
+// refreshTokenExpiry returns the session deadline and the lifetime of a new refresh
+// token: the idle timeout, cut short where the session would end first.
+func (s *OauthService) refreshTokenExpiry(client *models.OauthClient, sessionExpireTime int64) (sessionExpire int64, expireSeconds int64) {
+	now := time.Now()
+	if sessionExpireTime == 0 && s.options.RefreshTokenMaxLifetime > 0 {
+		sessionExpireTime = now.Add(s.options.RefreshTokenMaxLifetime).Unix()
+	}
+
+	expireSeconds = int64(s.refreshTokenIdleTimeout(client) / time.Second)
+	if sessionExpireTime > 0 {
+		remaining := sessionExpireTime - now.Unix()
+		if remaining < 0 {
+			remaining = 0
+		}
+
+		if remaining < expireSeconds {
+			expireSeconds = remaining
+		}
+	}
+
+	return sessionExpireTime, expireSeconds
+}
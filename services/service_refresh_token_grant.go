@@ -1,20 +1,100 @@
 package services
 
 import (
-	"github.com/NeuronFramework/errors"
 	"github.com/NeuronFramework/restful"
+	"github.com/NeuronFramework/sql/wrap"
 	"github.com/NeuronOauth/oauth/models"
+	"go.uber.org/zap"
+	"strings"
 )
 
+// RefreshTokenGrant rotates the presented refresh token. A refresh token that is
+// presented again after rotation revokes its whole family, since either the client
+// or an attacker holds a stolen copy (OAuth 2.0 Security BCP §4.14.2).
 func (s *OauthService) RefreshTokenGrant(ctx *restful.Context, refreshToken string, scope string, client *models.OauthClient) (accessToken *models.AccessToken, err error) {
-	dbRefreshToken, err := s.oauthDB.RefreshToken.GetQuery().RefreshToken_Equal(refreshToken).QueryOne(ctx, nil)
+	err = s.checkClientGrant(client, GrantTypeRefreshToken)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 
-	if dbRefreshToken == nil {
-		return nil, errors.InvalidParam("无效的RefreshToken")
+	reusedFamilyId := ""
+	err = s.oauthDB.TransactionReadCommitted(ctx, func(tx *wrap.Tx) (err error) {
+		dbRefreshToken, err := s.oauthDB.RefreshToken.GetQuery().
+			RefreshToken_Equal(refreshToken).
+			ForUpdate().
+			QueryOne(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		if dbRefreshToken == nil {
+			return NewOauthError(ErrorInvalidGrant, "invalid refresh token")
+		}
+
+		if dbRefreshToken.ClientId != client.ClientId {
+			return NewOauthError(ErrorInvalidGrant, "invalid refresh token")
+		}
+
+		familyId := dbRefreshToken.FamilyId
+		if familyId == "" {
+			familyId = dbRefreshToken.RefreshToken
+		}
+
+		if dbRefreshToken.Used != 0 {
+			reusedFamilyId = familyId
+			return nil
+		}
+
+		if s.isExpired(dbRefreshToken.ExpireTime) {
+			return NewOauthError(ErrorInvalidGrant, "refresh token expired")
+		}
+
+		// RFC 6749 §6, the new access token may be narrowed to a subset of the original grant
+		grantedScopes := parseScope(dbRefreshToken.OauthScope)
+		scopes := normalizeScope(parseScope(scope))
+		if len(scopes) == 0 {
+			scopes = grantedScopes
+		}
+
+		if !scopeAllowed(scopes, grantedScopes) {
+			return NewOauthError(ErrorInvalidScope, "scope exceeds the original grant")
+		}
+
+		dbRefreshToken.Used = 1
+		err = s.oauthDB.RefreshToken.Update(ctx, tx, dbRefreshToken)
+		if err != nil {
+			return err
+		}
+
+		accessToken, err = s.newAccessToken(ctx, tx, &tokenGrant{
+			Client:            client,
+			AccountId:         dbRefreshToken.AccountId,
+			Scope:             strings.Join(scopes, " "),
+			AuthorizationCode: dbRefreshToken.AuthorizationCode,
+			IssueRefreshToken: true,
+			RefreshScope:      dbRefreshToken.OauthScope,
+			FamilyId:          familyId,
+			SessionExpireTime: dbRefreshToken.SessionExpireTime,
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if reusedFamilyId != "" {
+		s.logger.Warn("RefreshToken reused", zap.String("clientId", client.ClientId))
+		err = s.revokeRefreshTokenFamily(ctx, reusedFamilyId)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, NewOauthError(ErrorInvalidGrant, "invalid refresh token")
 	}
 
-	return s.newAccessToken(ctx, dbRefreshToken.ClientId, dbRefreshToken.AccountId, dbRefreshToken.OauthScope)
+	return accessToken, nil
 }
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"github.com/NeuronFramework/restful"
+	"github.com/NeuronOauth/oauth/models"
+)
+
+const (
+	TokenTypeHintAccessToken  = "access_token"
+	TokenTypeHintRefreshToken = "refresh_token"
+)
+
+// Revoke implements RFC 7009. Unknown tokens are not an error, the client cannot
+// do anything about them and the goal of revocation is already met.
+func (s *OauthService) Revoke(ctx *restful.Context, token string, tokenTypeHint string, client *models.OauthClient) (err error) {
+	// the hint only decides which table is searched first (RFC 7009 §2.1)
+	if tokenTypeHint == TokenTypeHintRefreshToken {
+		found, err := s.revokeRefreshToken(ctx, token, client)
+		if err != nil || found {
+			return err
+		}
+
+		_, err = s.revokeAccessToken(ctx, token, client)
+		return err
+	}
+
+	found, err := s.revokeAccessToken(ctx, token, client)
+	if err != nil || found {
+		return err
+	}
+
+	_, err = s.revokeRefreshToken(ctx, token, client)
+	return err
+}
+
+func (s *OauthService) revokeAccessToken(ctx *restful.Context, accessToken string, client *models.OauthClient) (found bool, err error) {
+	dbAccessToken, err := s.getAccessToken(ctx, accessToken)
+	if err != nil {
+		return false, err
+	}
+
+	if dbAccessToken == nil {
+		return false, nil
+	}
+
+	if dbAccessToken.ClientId != client.ClientId {
+		return true, NewOauthError(ErrorUnauthorizedClient, "token was not issued to this client")
+	}
+
+	err = s.oauthDB.AccessToken.Delete(ctx, nil, dbAccessToken.Id)
+	if err != nil {
+		return true, err
+	}
+
+	return true, nil
+}
+
+func (s *OauthService) revokeRefreshToken(ctx *restful.Context, refreshToken string, client *models.OauthClient) (found bool, err error) {
+	dbRefreshToken, err := s.oauthDB.RefreshToken.GetQuery().RefreshToken_Equal(refreshToken).QueryOne(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+
+	if dbRefreshToken == nil {
+		return false, nil
+	}
+
+	if dbRefreshToken.ClientId != client.ClientId {
+		return true, NewOauthError(ErrorUnauthorizedClient, "token was not issued to this client")
+	}
+
+	// with rotation the presented token is only the latest of its family, the
+	// session it belongs to is what the client asks to end
+	if dbRefreshToken.FamilyId != "" {
+		return true, s.revokeRefreshTokenFamily(ctx, dbRefreshToken.FamilyId)
+	}
+
+	return true, s.deleteRefreshToken(ctx, dbRefreshToken)
+}
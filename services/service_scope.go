@@ -0,0 +1,139 @@
+package services
+
+import (
+	"github.com/NeuronFramework/errors"
+	"github.com/NeuronFramework/restful"
+	"github.com/NeuronOauth/oauth/models"
+	"github.com/NeuronOauth/oauth/storages/oauth_db"
+)
+
+func (s *OauthService) CreateScope(ctx *restful.Context, scope string, description string) (r *models.OauthScope, err error) {
+	if !scopeTokenRegexp.MatchString(scope) {
+		return nil, errors.InvalidParam("无效的Scope")
+	}
+
+	dbScope, err := s.oauthDB.OauthScope.GetQuery().OauthScope_Equal(scope).QueryOne(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if dbScope != nil {
+		return nil, errors.InvalidParam("Scope已存在")
+	}
+
+	dbScope = &oauth_db.OauthScope{}
+	dbScope.OauthScope = scope
+	dbScope.ScopeDesc = description
+	_, err = s.oauthDB.OauthScope.Insert(ctx, nil, dbScope)
+	if err != nil {
+		return nil, err
+	}
+
+	return oauth_db.FromOauthScope(dbScope), nil
+}
+
+func (s *OauthService) GetScope(ctx *restful.Context, scope string) (r *models.OauthScope, err error) {
+	dbScope, err := s.getScope(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return oauth_db.FromOauthScope(dbScope), nil
+}
+
+func (s *OauthService) ListScopes(ctx *restful.Context) (scopes []*models.OauthScope, err error) {
+	dbScopes, err := s.oauthDB.OauthScope.GetQuery().
+		OrderBy(oauth_db.OAUTH_SCOPE_FIELD_OAUTH_SCOPE, true).
+		QueryList(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes = make([]*models.OauthScope, 0, len(dbScopes))
+	for _, v := range dbScopes {
+		scopes = append(scopes, oauth_db.FromOauthScope(v))
+	}
+
+	return scopes, nil
+}
+
+func (s *OauthService) UpdateScope(ctx *restful.Context, scope string, description *string) (r *models.OauthScope, err error) {
+	dbScope, err := s.getScope(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if description != nil {
+		dbScope.ScopeDesc = *description
+	}
+
+	err = s.oauthDB.OauthScope.Update(ctx, nil, dbScope)
+	if err != nil {
+		return nil, err
+	}
+
+	return oauth_db.FromOauthScope(dbScope), nil
+}
+
+// DeleteScope unregisters a scope. Tokens already carrying it are left alone,
+// new authorization requests for it are rejected. A scope still allowed to or
+// defaulted by a client cannot be deleted, the client could no longer be updated.
+func (s *OauthService) DeleteScope(ctx *restful.Context, scope string) (err error) {
+	dbScope, err := s.getScope(ctx, scope)
+	if err != nil {
+		return err
+	}
+
+	clientId, err := s.findClientUsingScope(ctx, scope)
+	if err != nil {
+		return err
+	}
+
+	if clientId != "" {
+		return errors.InvalidParam("Scope仍被Client使用:" + clientId)
+	}
+
+	return s.oauthDB.OauthScope.Delete(ctx, nil, dbScope.Id)
+}
+
+// findClientUsingScope returns a client whose allowed or default scopes contain
+// scope, empty when there is none.
+func (s *OauthService) findClientUsingScope(ctx *restful.Context, scope string) (clientId string, err error) {
+	const pageSize = 500
+
+	lastId := uint64(0)
+	for {
+		dbClients, err := s.oauthDB.OauthClient.GetQuery().
+			Id_Greater(lastId).
+			OrderBy(oauth_db.OAUTH_CLIENT_FIELD_ID, true).
+			Limit(0, pageSize).
+			QueryList(ctx, nil)
+		if err != nil {
+			return "", err
+		}
+
+		for _, v := range dbClients {
+			if containsString(parseScope(v.OauthScope), scope) || containsString(parseScope(v.DefaultScope), scope) {
+				return v.ClientId, nil
+			}
+			lastId = v.Id
+		}
+
+		if len(dbClients) < pageSize {
+			return "", nil
+		}
+	}
+}
+
+func (s *OauthService) getScope(ctx *restful.Context, scope string) (dbScope *oauth_db.OauthScope, err error) {
+	dbScope, err = s.oauthDB.OauthScope.GetQuery().OauthScope_Equal(scope).QueryOne(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if dbScope == nil {
+		return nil, errors.NotFound("Scope不存在")
+	}
+
+	return dbScope, nil
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"github.com/NeuronFramework/errors"
+	"github.com/NeuronFramework/restful"
+	"github.com/NeuronOauth/oauth/models"
+	"strings"
+)
+
+// OpenidConfiguration is the OIDC Discovery document. Without a signing key there
+// are no id_tokens and none of the jwks_uri, subject_types_supported and
+// id_token_signing_alg_values_supported fields §3 requires, so it is not served.
+func (s *OauthService) OpenidConfiguration(ctx *restful.Context) (metadata *models.ServerMetadata, err error) {
+	if s.signingMethod == nil {
+		return nil, errors.NotFound("未配置签名密钥,不支持OpenID Connect")
+	}
+
+	return s.ServerMetadata(ctx)
+}
+
+// ServerMetadata describes this server for RFC 8414 and OIDC Discovery. It is
+// derived from the running configuration so it cannot drift from what is served.
+func (s *OauthService) ServerMetadata(ctx *restful.Context) (metadata *models.ServerMetadata, err error) {
+	if s.options.Issuer == "" {
+		return nil, errors.NotFound("未配置Issuer")
+	}
+
+	// RFC 8414 §2 and OIDC Discovery require it, as the code grant is always supported
+	if s.options.AuthorizationEndpoint == "" {
+		return nil, errors.NotFound("未配置AuthorizationEndpoint")
+	}
+
+	dbScopes, err := s.oauthDB.OauthScope.GetQuery().QueryList(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := make([]string, 0, len(dbScopes)+1)
+	for _, v := range dbScopes {
+		// an openid row means nothing without a key to sign id_tokens with
+		if v.OauthScope == ScopeOpenId && s.signingMethod == nil {
+			continue
+		}
+		scopes = append(scopes, v.OauthScope)
+	}
+
+	baseUrl := strings.TrimSuffix(s.options.Issuer, "/")
+
+	metadata = &models.ServerMetadata{}
+	metadata.Issuer = s.options.Issuer
+	metadata.AuthorizationEndpoint = s.options.AuthorizationEndpoint
+	metadata.TokenEndpoint = baseUrl + "/token"
+	metadata.UserinfoEndpoint = baseUrl + "/me"
+	metadata.RevocationEndpoint = baseUrl + "/revoke"
+	metadata.IntrospectionEndpoint = baseUrl + "/introspect"
+	metadata.ResponseTypesSupported = []string{ResponseTypeCode}
+	metadata.GrantTypesSupported = s.grantTypes()
+	metadata.TokenEndpointAuthMethodsSupported = s.clientAuthMethods()
+	metadata.RevocationEndpointAuthMethodsSupported = s.clientAuthMethods()
+	metadata.IntrospectionEndpointAuthMethodsSupported = s.introspectionAuthMethods()
+	// RFC 8414 §2 requires the algorithms once private_key_jwt is listed
+	metadata.TokenEndpointAuthSigningAlgValuesSupported = clientAssertionAlgorithms
+	metadata.RevocationEndpointAuthSigningAlgValuesSupported = clientAssertionAlgorithms
+	metadata.IntrospectionEndpointAuthSigningAlgValuesSupported = clientAssertionAlgorithms
+	metadata.CodeChallengeMethodsSupported = []string{CodeChallengeMethodPlain, CodeChallengeMethodS256}
+	metadata.TlsClientCertificateBoundAccessTokens = s.options.MtlsEnabled
+
+	// everything OIDC hinges on having a key to sign id_tokens with
+	if s.signingMethod != nil {
+		if !containsString(scopes, ScopeOpenId) {
+			scopes = append(scopes, ScopeOpenId)
+		}
+		metadata.JwksUri = baseUrl + "/.well-known/jwks.json"
+		metadata.SubjectTypesSupported = []string{"public"}
+		metadata.IdTokenSigningAlgValuesSupported = []string{s.signingMethod.Alg()}
+		metadata.ClaimsSupported = s.supportedClaims()
+	}
+
+	metadata.ScopesSupported = scopes
+
+	return metadata, nil
+}
@@ -12,6 +12,16 @@ func FromOauthClient(p *OauthClient) (r *models.OauthClient) {
 	r.PasswordHash = p.PasswordHash
 	r.AccountId = p.AccountId
 	r.RedirectUri = p.RedirectUri
+	r.Scope = p.OauthScope
+	r.ClientType = p.ClientType
+	r.GrantTypes = p.GrantTypes
+	r.DefaultScope = p.DefaultScope
+	r.AccessTokenTTL = p.AccessTokenTtl
+	r.RefreshTokenTTL = p.RefreshTokenTtl
+	r.IssueRefreshToken = p.IssueRefreshToken != 0
+	r.TokenEndpointAuthMethod = p.TokenEndpointAuthMethod
+	r.Jwks = p.Jwks
+	r.TlsClientAuthSubjectDn = p.TlsClientAuthSubjectDn
 
 	return r
 }
@@ -28,6 +38,8 @@ func FromAuthorizationCode(p *AuthorizationCode) (r *models.AuthorizationCode) {
 	r.Scope = p.OauthScope
 	r.ExpireSeconds = p.ExpireSeconds
 	r.RedirectUri = p.RedirectUri
+	r.CodeChallenge = p.CodeChallenge
+	r.CodeChallengeMethod = p.CodeChallengeMethod
 
 	return r
 }
@@ -46,3 +58,15 @@ func FromAccessToken(p *AccessToken) (r *models.AccessToken) {
 
 	return r
 }
+
+func FromOauthScope(p *OauthScope) (r *models.OauthScope) {
+	if p == nil {
+		return nil
+	}
+
+	r = &models.OauthScope{}
+	r.Scope = p.OauthScope
+	r.Description = p.ScopeDesc
+
+	return r
+}
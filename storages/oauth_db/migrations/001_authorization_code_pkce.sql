@@ -0,0 +1,3 @@
+ALTER TABLE `authorization_code`
+  ADD COLUMN `code_challenge` varchar(128) NOT NULL DEFAULT '',
+  ADD COLUMN `code_challenge_method` varchar(16) NOT NULL DEFAULT '';
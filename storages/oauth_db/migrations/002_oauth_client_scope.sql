@@ -0,0 +1,2 @@
+ALTER TABLE `oauth_client`
+  ADD COLUMN `oauth_scope` varchar(1024) NOT NULL DEFAULT '';
@@ -0,0 +1,10 @@
+ALTER TABLE `authorization_code`
+  ADD COLUMN `used` int(11) NOT NULL DEFAULT '0';
+
+ALTER TABLE `access_token`
+  ADD COLUMN `authorization_code` varchar(128) NOT NULL DEFAULT '',
+  ADD KEY `idx_authorization_code` (`authorization_code`);
+
+ALTER TABLE `refresh_token`
+  ADD COLUMN `authorization_code` varchar(128) NOT NULL DEFAULT '',
+  ADD KEY `idx_authorization_code` (`authorization_code`);
@@ -0,0 +1,2 @@
+ALTER TABLE `oauth_client`
+  MODIFY COLUMN `redirect_uri` varchar(2048) NOT NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE `access_token`
+  ADD COLUMN `refresh_token` varchar(128) NOT NULL DEFAULT '',
+  ADD KEY `idx_refresh_token` (`refresh_token`);
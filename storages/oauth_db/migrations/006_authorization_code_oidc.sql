@@ -0,0 +1,3 @@
+ALTER TABLE `authorization_code`
+  ADD COLUMN `nonce` varchar(256) NOT NULL DEFAULT '',
+  ADD COLUMN `auth_time` bigint(20) NOT NULL DEFAULT '0';
@@ -0,0 +1,6 @@
+ALTER TABLE `refresh_token`
+  ADD COLUMN `family_id` varchar(128) NOT NULL DEFAULT '',
+  ADD COLUMN `used` int(11) NOT NULL DEFAULT '0',
+  ADD KEY `idx_family_id` (`family_id`);
+
+UPDATE `refresh_token` SET `family_id` = `refresh_token` WHERE `family_id` = '';
@@ -0,0 +1,2 @@
+ALTER TABLE `refresh_token`
+  ADD COLUMN `session_expire_time` bigint(20) NOT NULL DEFAULT '0';
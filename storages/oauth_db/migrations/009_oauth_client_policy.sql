@@ -0,0 +1,7 @@
+ALTER TABLE `oauth_client`
+  ADD COLUMN `client_type` varchar(32) NOT NULL DEFAULT 'confidential',
+  ADD COLUMN `grant_types` varchar(256) NOT NULL DEFAULT '',
+  ADD COLUMN `default_scope` varchar(1024) NOT NULL DEFAULT '',
+  ADD COLUMN `access_token_ttl` bigint(20) NOT NULL DEFAULT '0',
+  ADD COLUMN `refresh_token_ttl` bigint(20) NOT NULL DEFAULT '0',
+  ADD COLUMN `issue_refresh_token` int(11) NOT NULL DEFAULT '1';
@@ -0,0 +1,2 @@
+ALTER TABLE `oauth_client`
+  ADD COLUMN `token_endpoint_auth_method` varchar(64) NOT NULL DEFAULT 'client_secret_basic';
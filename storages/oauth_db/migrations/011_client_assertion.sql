@@ -0,0 +1,14 @@
+ALTER TABLE `oauth_client`
+  ADD COLUMN `jwks` text NOT NULL;
+
+CREATE TABLE `client_assertion` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `client_id` varchar(128) NOT NULL,
+  `jti` varchar(128) NOT NULL,
+  `expire_time` bigint(20) NOT NULL,
+  `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_client_jti` (`client_id`,`jti`),
+  KEY `idx_expire_time` (`expire_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,5 @@
+ALTER TABLE `oauth_client`
+  ADD COLUMN `tls_client_auth_subject_dn` varchar(1024) NOT NULL DEFAULT '';
+
+ALTER TABLE `access_token`
+  ADD COLUMN `cnf_x5t_s256` varchar(64) NOT NULL DEFAULT '';
@@ -0,0 +1,18 @@
+ALTER TABLE `authorization_code`
+  ADD COLUMN `expire_time` bigint(20) NOT NULL DEFAULT '0';
+
+UPDATE `authorization_code` SET `expire_time` = UNIX_TIMESTAMP(`create_time`) + `expire_seconds`;
+
+ALTER TABLE `access_token`
+  ADD COLUMN `issue_time` bigint(20) NOT NULL DEFAULT '0',
+  ADD COLUMN `expire_time` bigint(20) NOT NULL DEFAULT '0';
+
+UPDATE `access_token` SET `issue_time` = UNIX_TIMESTAMP(`create_time`),
+  `expire_time` = UNIX_TIMESTAMP(`create_time`) + `expire_seconds`;
+
+ALTER TABLE `refresh_token`
+  ADD COLUMN `issue_time` bigint(20) NOT NULL DEFAULT '0',
+  ADD COLUMN `expire_time` bigint(20) NOT NULL DEFAULT '0';
+
+UPDATE `refresh_token` SET `issue_time` = UNIX_TIMESTAMP(`create_time`),
+  `expire_time` = UNIX_TIMESTAMP(`create_time`) + `expire_seconds`;
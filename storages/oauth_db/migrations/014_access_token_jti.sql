@@ -0,0 +1,7 @@
+ALTER TABLE `access_token`
+  ADD COLUMN `jti` varchar(128) NOT NULL DEFAULT '';
+
+UPDATE `access_token` SET `jti` = `access_token`;
+
+ALTER TABLE `access_token`
+  ADD UNIQUE KEY `idx_jti` (`jti`);
@@ -71,8 +71,14 @@ const ACCESS_TOKEN_FIELD_EXPIRE_SECONDS = ACCESS_TOKEN_FIELD("expire_seconds")
 const ACCESS_TOKEN_FIELD_OAUTH_SCOPE = ACCESS_TOKEN_FIELD("oauth_scope")
 const ACCESS_TOKEN_FIELD_CREATE_TIME = ACCESS_TOKEN_FIELD("create_time")
 const ACCESS_TOKEN_FIELD_UPDATE_TIME = ACCESS_TOKEN_FIELD("update_time")
+const ACCESS_TOKEN_FIELD_AUTHORIZATION_CODE = ACCESS_TOKEN_FIELD("authorization_code")
+const ACCESS_TOKEN_FIELD_REFRESH_TOKEN = ACCESS_TOKEN_FIELD("refresh_token")
+const ACCESS_TOKEN_FIELD_CNF_X5T_S256 = ACCESS_TOKEN_FIELD("cnf_x5t_s256")
+const ACCESS_TOKEN_FIELD_ISSUE_TIME = ACCESS_TOKEN_FIELD("issue_time")
+const ACCESS_TOKEN_FIELD_EXPIRE_TIME = ACCESS_TOKEN_FIELD("expire_time")
+const ACCESS_TOKEN_FIELD_JTI = ACCESS_TOKEN_FIELD("jti")
 
-const ACCESS_TOKEN_ALL_FIELDS_STRING = "id,access_token,client_id,account_id,expire_seconds,oauth_scope,create_time,update_time"
+const ACCESS_TOKEN_ALL_FIELDS_STRING = "id,access_token,client_id,account_id,expire_seconds,oauth_scope,create_time,update_time,authorization_code,refresh_token,cnf_x5t_s256,issue_time,expire_time,jti"
 
 var ACCESS_TOKEN_ALL_FIELDS = []string{
 	"id",
@@ -83,17 +89,29 @@ var ACCESS_TOKEN_ALL_FIELDS = []string{
 	"oauth_scope",
 	"create_time",
 	"update_time",
+	"authorization_code",
+	"refresh_token",
+	"cnf_x5t_s256",
+	"issue_time",
+	"expire_time",
+	"jti",
 }
 
 type AccessToken struct {
-	Id            uint64 //size=20
-	AccessToken   string //size=128
-	ClientId      string //size=128
-	AccountId     string //size=128
-	ExpireSeconds int64  //size=20
-	OauthScope    string //size=256
-	CreateTime    time.Time
-	UpdateTime    time.Time
+	Id                uint64 //size=20
+	AccessToken       string //size=128
+	ClientId          string //size=128
+	AccountId         string //size=128
+	ExpireSeconds     int64  //size=20
+	OauthScope        string //size=256
+	CreateTime        time.Time
+	UpdateTime        time.Time
+	AuthorizationCode string //size=128
+	RefreshToken      string //size=128
+	CnfX5tS256        string //size=64
+	IssueTime         int64  //size=20
+	ExpireTime        int64  //size=20
+	Jti               string //size=128
 }
 
 type AccessTokenQuery struct {
@@ -328,6 +346,114 @@ func (q *AccessTokenQuery) UpdateTime_Greater(v time.Time) *AccessTokenQuery {
 func (q *AccessTokenQuery) UpdateTime_GreaterEqual(v time.Time) *AccessTokenQuery {
 	return q.w("update_time>='" + fmt.Sprint(v) + "'")
 }
+func (q *AccessTokenQuery) AuthorizationCode_Equal(v string) *AccessTokenQuery {
+	return q.w("authorization_code='" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) AuthorizationCode_NotEqual(v string) *AccessTokenQuery {
+	return q.w("authorization_code<>'" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) AuthorizationCode_Less(v string) *AccessTokenQuery {
+	return q.w("authorization_code<'" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) AuthorizationCode_LessEqual(v string) *AccessTokenQuery {
+	return q.w("authorization_code<='" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) AuthorizationCode_Greater(v string) *AccessTokenQuery {
+	return q.w("authorization_code>'" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) AuthorizationCode_GreaterEqual(v string) *AccessTokenQuery {
+	return q.w("authorization_code>='" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) RefreshToken_Equal(v string) *AccessTokenQuery {
+	return q.w("refresh_token='" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) RefreshToken_NotEqual(v string) *AccessTokenQuery {
+	return q.w("refresh_token<>'" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) RefreshToken_Less(v string) *AccessTokenQuery {
+	return q.w("refresh_token<'" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) RefreshToken_LessEqual(v string) *AccessTokenQuery {
+	return q.w("refresh_token<='" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) RefreshToken_Greater(v string) *AccessTokenQuery {
+	return q.w("refresh_token>'" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) RefreshToken_GreaterEqual(v string) *AccessTokenQuery {
+	return q.w("refresh_token>='" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) CnfX5tS256_Equal(v string) *AccessTokenQuery {
+	return q.w("cnf_x5t_s256='" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) CnfX5tS256_NotEqual(v string) *AccessTokenQuery {
+	return q.w("cnf_x5t_s256<>'" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) CnfX5tS256_Less(v string) *AccessTokenQuery {
+	return q.w("cnf_x5t_s256<'" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) CnfX5tS256_LessEqual(v string) *AccessTokenQuery {
+	return q.w("cnf_x5t_s256<='" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) CnfX5tS256_Greater(v string) *AccessTokenQuery {
+	return q.w("cnf_x5t_s256>'" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) CnfX5tS256_GreaterEqual(v string) *AccessTokenQuery {
+	return q.w("cnf_x5t_s256>='" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) IssueTime_Equal(v int64) *AccessTokenQuery {
+	return q.w("issue_time='" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) IssueTime_NotEqual(v int64) *AccessTokenQuery {
+	return q.w("issue_time<>'" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) IssueTime_Less(v int64) *AccessTokenQuery {
+	return q.w("issue_time<'" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) IssueTime_LessEqual(v int64) *AccessTokenQuery {
+	return q.w("issue_time<='" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) IssueTime_Greater(v int64) *AccessTokenQuery {
+	return q.w("issue_time>'" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) IssueTime_GreaterEqual(v int64) *AccessTokenQuery {
+	return q.w("issue_time>='" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) ExpireTime_Equal(v int64) *AccessTokenQuery {
+	return q.w("expire_time='" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) ExpireTime_NotEqual(v int64) *AccessTokenQuery {
+	return q.w("expire_time<>'" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) ExpireTime_Less(v int64) *AccessTokenQuery {
+	return q.w("expire_time<'" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) ExpireTime_LessEqual(v int64) *AccessTokenQuery {
+	return q.w("expire_time<='" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) ExpireTime_Greater(v int64) *AccessTokenQuery {
+	return q.w("expire_time>'" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) ExpireTime_GreaterEqual(v int64) *AccessTokenQuery {
+	return q.w("expire_time>='" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) Jti_Equal(v string) *AccessTokenQuery {
+	return q.w("jti='" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) Jti_NotEqual(v string) *AccessTokenQuery {
+	return q.w("jti<>'" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) Jti_Less(v string) *AccessTokenQuery {
+	return q.w("jti<'" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) Jti_LessEqual(v string) *AccessTokenQuery {
+	return q.w("jti<='" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) Jti_Greater(v string) *AccessTokenQuery {
+	return q.w("jti>'" + fmt.Sprint(v) + "'")
+}
+func (q *AccessTokenQuery) Jti_GreaterEqual(v string) *AccessTokenQuery {
+	return q.w("jti>='" + fmt.Sprint(v) + "'")
+}
 
 type AccessTokenDao struct {
 	logger     *zap.Logger
@@ -369,12 +495,12 @@ func (dao *AccessTokenDao) init() (err error) {
 }
 
 func (dao *AccessTokenDao) prepareInsertStmt() (err error) {
-	dao.insertStmt, err = dao.db.Prepare(context.Background(), "INSERT INTO access_token (access_token,client_id,account_id,expire_seconds,oauth_scope) VALUES (?,?,?,?,?)")
+	dao.insertStmt, err = dao.db.Prepare(context.Background(), "INSERT INTO access_token (access_token,client_id,account_id,expire_seconds,oauth_scope,authorization_code,refresh_token,cnf_x5t_s256,issue_time,expire_time,jti) VALUES (?,?,?,?,?,?,?,?,?,?,?)")
 	return err
 }
 
 func (dao *AccessTokenDao) prepareUpdateStmt() (err error) {
-	dao.updateStmt, err = dao.db.Prepare(context.Background(), "UPDATE access_token SET access_token=?,client_id=?,account_id=?,expire_seconds=?,oauth_scope=? WHERE id=?")
+	dao.updateStmt, err = dao.db.Prepare(context.Background(), "UPDATE access_token SET access_token=?,client_id=?,account_id=?,expire_seconds=?,oauth_scope=?,authorization_code=?,refresh_token=?,cnf_x5t_s256=?,issue_time=?,expire_time=?,jti=? WHERE id=?")
 	return err
 }
 
@@ -389,7 +515,7 @@ func (dao *AccessTokenDao) Insert(ctx context.Context, tx *wrap.Tx, e *AccessTok
 		stmt = tx.Stmt(ctx, stmt)
 	}
 
-	result, err := stmt.Exec(ctx, e.AccessToken, e.ClientId, e.AccountId, e.ExpireSeconds, e.OauthScope)
+	result, err := stmt.Exec(ctx, e.AccessToken, e.ClientId, e.AccountId, e.ExpireSeconds, e.OauthScope, e.AuthorizationCode, e.RefreshToken, e.CnfX5tS256, e.IssueTime, e.ExpireTime, e.Jti)
 	if err != nil {
 		return 0, err
 	}
@@ -408,7 +534,7 @@ func (dao *AccessTokenDao) Update(ctx context.Context, tx *wrap.Tx, e *AccessTok
 		stmt = tx.Stmt(ctx, stmt)
 	}
 
-	_, err = stmt.Exec(ctx, e.AccessToken, e.ClientId, e.AccountId, e.ExpireSeconds, e.OauthScope, e.Id)
+	_, err = stmt.Exec(ctx, e.AccessToken, e.ClientId, e.AccountId, e.ExpireSeconds, e.OauthScope, e.AuthorizationCode, e.RefreshToken, e.CnfX5tS256, e.IssueTime, e.ExpireTime, e.Jti, e.Id)
 	if err != nil {
 		return err
 	}
@@ -432,7 +558,7 @@ func (dao *AccessTokenDao) Delete(ctx context.Context, tx *wrap.Tx, id uint64) (
 
 func (dao *AccessTokenDao) scanRow(row *wrap.Row) (*AccessToken, error) {
 	e := &AccessToken{}
-	err := row.Scan(&e.Id, &e.AccessToken, &e.ClientId, &e.AccountId, &e.ExpireSeconds, &e.OauthScope, &e.CreateTime, &e.UpdateTime)
+	err := row.Scan(&e.Id, &e.AccessToken, &e.ClientId, &e.AccountId, &e.ExpireSeconds, &e.OauthScope, &e.CreateTime, &e.UpdateTime, &e.AuthorizationCode, &e.RefreshToken, &e.CnfX5tS256, &e.IssueTime, &e.ExpireTime, &e.Jti)
 	if err != nil {
 		if err == wrap.ErrNoRows {
 			return nil, nil
@@ -448,7 +574,7 @@ func (dao *AccessTokenDao) scanRows(rows *wrap.Rows) (list []*AccessToken, err e
 	list = make([]*AccessToken, 0)
 	for rows.Next() {
 		e := AccessToken{}
-		err = rows.Scan(&e.Id, &e.AccessToken, &e.ClientId, &e.AccountId, &e.ExpireSeconds, &e.OauthScope, &e.CreateTime, &e.UpdateTime)
+		err = rows.Scan(&e.Id, &e.AccessToken, &e.ClientId, &e.AccountId, &e.ExpireSeconds, &e.OauthScope, &e.CreateTime, &e.UpdateTime, &e.AuthorizationCode, &e.RefreshToken, &e.CnfX5tS256, &e.IssueTime, &e.ExpireTime, &e.Jti)
 		if err != nil {
 			return nil, err
 		}
@@ -537,8 +663,14 @@ const AUTHORIZATION_CODE_FIELD_EXPIRE_SECONDS = AUTHORIZATION_CODE_FIELD("expire
 const AUTHORIZATION_CODE_FIELD_CREATE_TIME = AUTHORIZATION_CODE_FIELD("create_time")
 const AUTHORIZATION_CODE_FIELD_UPDATE_TIME = AUTHORIZATION_CODE_FIELD("update_time")
 const AUTHORIZATION_CODE_FIELD_USER_AGENT = AUTHORIZATION_CODE_FIELD("user_agent")
+const AUTHORIZATION_CODE_FIELD_CODE_CHALLENGE = AUTHORIZATION_CODE_FIELD("code_challenge")
+const AUTHORIZATION_CODE_FIELD_CODE_CHALLENGE_METHOD = AUTHORIZATION_CODE_FIELD("code_challenge_method")
+const AUTHORIZATION_CODE_FIELD_USED = AUTHORIZATION_CODE_FIELD("used")
+const AUTHORIZATION_CODE_FIELD_NONCE = AUTHORIZATION_CODE_FIELD("nonce")
+const AUTHORIZATION_CODE_FIELD_AUTH_TIME = AUTHORIZATION_CODE_FIELD("auth_time")
+const AUTHORIZATION_CODE_FIELD_EXPIRE_TIME = AUTHORIZATION_CODE_FIELD("expire_time")
 
-const AUTHORIZATION_CODE_ALL_FIELDS_STRING = "id,authorization_code,client_id,account_id,redirect_uri,oauth_scope,expire_seconds,create_time,update_time,user_agent"
+const AUTHORIZATION_CODE_ALL_FIELDS_STRING = "id,authorization_code,client_id,account_id,redirect_uri,oauth_scope,expire_seconds,create_time,update_time,user_agent,code_challenge,code_challenge_method,used,nonce,auth_time,expire_time"
 
 var AUTHORIZATION_CODE_ALL_FIELDS = []string{
 	"id",
@@ -551,19 +683,31 @@ var AUTHORIZATION_CODE_ALL_FIELDS = []string{
 	"create_time",
 	"update_time",
 	"user_agent",
+	"code_challenge",
+	"code_challenge_method",
+	"used",
+	"nonce",
+	"auth_time",
+	"expire_time",
 }
 
 type AuthorizationCode struct {
-	Id                uint64 //size=20
-	AuthorizationCode string //size=128
-	ClientId          string //size=128
-	AccountId         string //size=128
-	RedirectUri       string //size=256
-	OauthScope        string //size=256
-	ExpireSeconds     int64  //size=20
-	CreateTime        time.Time
-	UpdateTime        time.Time
-	UserAgent         string //size=256
+	Id                  uint64 //size=20
+	AuthorizationCode   string //size=128
+	ClientId            string //size=128
+	AccountId           string //size=128
+	RedirectUri         string //size=256
+	OauthScope          string //size=256
+	ExpireSeconds       int64  //size=20
+	CreateTime          time.Time
+	UpdateTime          time.Time
+	UserAgent           string //size=256
+	CodeChallenge       string //size=128
+	CodeChallengeMethod string //size=16
+	Used                int32  //size=11
+	Nonce               string //size=256
+	AuthTime            int64  //size=20
+	ExpireTime          int64  //size=20
 }
 
 type AuthorizationCodeQuery struct {
@@ -683,161 +827,693 @@ func (q *AuthorizationCodeQuery) AuthorizationCode_NotEqual(v string) *Authoriza
 func (q *AuthorizationCodeQuery) AuthorizationCode_Less(v string) *AuthorizationCodeQuery {
 	return q.w("authorization_code<'" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) AuthorizationCode_LessEqual(v string) *AuthorizationCodeQuery {
-	return q.w("authorization_code<='" + fmt.Sprint(v) + "'")
+func (q *AuthorizationCodeQuery) AuthorizationCode_LessEqual(v string) *AuthorizationCodeQuery {
+	return q.w("authorization_code<='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) AuthorizationCode_Greater(v string) *AuthorizationCodeQuery {
+	return q.w("authorization_code>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) AuthorizationCode_GreaterEqual(v string) *AuthorizationCodeQuery {
+	return q.w("authorization_code>='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) ClientId_Equal(v string) *AuthorizationCodeQuery {
+	return q.w("client_id='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) ClientId_NotEqual(v string) *AuthorizationCodeQuery {
+	return q.w("client_id<>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) ClientId_Less(v string) *AuthorizationCodeQuery {
+	return q.w("client_id<'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) ClientId_LessEqual(v string) *AuthorizationCodeQuery {
+	return q.w("client_id<='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) ClientId_Greater(v string) *AuthorizationCodeQuery {
+	return q.w("client_id>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) ClientId_GreaterEqual(v string) *AuthorizationCodeQuery {
+	return q.w("client_id>='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) AccountId_Equal(v string) *AuthorizationCodeQuery {
+	return q.w("account_id='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) AccountId_NotEqual(v string) *AuthorizationCodeQuery {
+	return q.w("account_id<>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) AccountId_Less(v string) *AuthorizationCodeQuery {
+	return q.w("account_id<'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) AccountId_LessEqual(v string) *AuthorizationCodeQuery {
+	return q.w("account_id<='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) AccountId_Greater(v string) *AuthorizationCodeQuery {
+	return q.w("account_id>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) AccountId_GreaterEqual(v string) *AuthorizationCodeQuery {
+	return q.w("account_id>='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) RedirectUri_Equal(v string) *AuthorizationCodeQuery {
+	return q.w("redirect_uri='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) RedirectUri_NotEqual(v string) *AuthorizationCodeQuery {
+	return q.w("redirect_uri<>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) RedirectUri_Less(v string) *AuthorizationCodeQuery {
+	return q.w("redirect_uri<'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) RedirectUri_LessEqual(v string) *AuthorizationCodeQuery {
+	return q.w("redirect_uri<='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) RedirectUri_Greater(v string) *AuthorizationCodeQuery {
+	return q.w("redirect_uri>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) RedirectUri_GreaterEqual(v string) *AuthorizationCodeQuery {
+	return q.w("redirect_uri>='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) OauthScope_Equal(v string) *AuthorizationCodeQuery {
+	return q.w("oauth_scope='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) OauthScope_NotEqual(v string) *AuthorizationCodeQuery {
+	return q.w("oauth_scope<>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) OauthScope_Less(v string) *AuthorizationCodeQuery {
+	return q.w("oauth_scope<'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) OauthScope_LessEqual(v string) *AuthorizationCodeQuery {
+	return q.w("oauth_scope<='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) OauthScope_Greater(v string) *AuthorizationCodeQuery {
+	return q.w("oauth_scope>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) OauthScope_GreaterEqual(v string) *AuthorizationCodeQuery {
+	return q.w("oauth_scope>='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) ExpireSeconds_Equal(v int64) *AuthorizationCodeQuery {
+	return q.w("expire_seconds='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) ExpireSeconds_NotEqual(v int64) *AuthorizationCodeQuery {
+	return q.w("expire_seconds<>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) ExpireSeconds_Less(v int64) *AuthorizationCodeQuery {
+	return q.w("expire_seconds<'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) ExpireSeconds_LessEqual(v int64) *AuthorizationCodeQuery {
+	return q.w("expire_seconds<='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) ExpireSeconds_Greater(v int64) *AuthorizationCodeQuery {
+	return q.w("expire_seconds>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) ExpireSeconds_GreaterEqual(v int64) *AuthorizationCodeQuery {
+	return q.w("expire_seconds>='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) CreateTime_Equal(v time.Time) *AuthorizationCodeQuery {
+	return q.w("create_time='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) CreateTime_NotEqual(v time.Time) *AuthorizationCodeQuery {
+	return q.w("create_time<>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) CreateTime_Less(v time.Time) *AuthorizationCodeQuery {
+	return q.w("create_time<'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) CreateTime_LessEqual(v time.Time) *AuthorizationCodeQuery {
+	return q.w("create_time<='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) CreateTime_Greater(v time.Time) *AuthorizationCodeQuery {
+	return q.w("create_time>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) CreateTime_GreaterEqual(v time.Time) *AuthorizationCodeQuery {
+	return q.w("create_time>='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) UpdateTime_Equal(v time.Time) *AuthorizationCodeQuery {
+	return q.w("update_time='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) UpdateTime_NotEqual(v time.Time) *AuthorizationCodeQuery {
+	return q.w("update_time<>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) UpdateTime_Less(v time.Time) *AuthorizationCodeQuery {
+	return q.w("update_time<'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) UpdateTime_LessEqual(v time.Time) *AuthorizationCodeQuery {
+	return q.w("update_time<='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) UpdateTime_Greater(v time.Time) *AuthorizationCodeQuery {
+	return q.w("update_time>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) UpdateTime_GreaterEqual(v time.Time) *AuthorizationCodeQuery {
+	return q.w("update_time>='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) UserAgent_Equal(v string) *AuthorizationCodeQuery {
+	return q.w("user_agent='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) UserAgent_NotEqual(v string) *AuthorizationCodeQuery {
+	return q.w("user_agent<>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) UserAgent_Less(v string) *AuthorizationCodeQuery {
+	return q.w("user_agent<'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) UserAgent_LessEqual(v string) *AuthorizationCodeQuery {
+	return q.w("user_agent<='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) UserAgent_Greater(v string) *AuthorizationCodeQuery {
+	return q.w("user_agent>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) UserAgent_GreaterEqual(v string) *AuthorizationCodeQuery {
+	return q.w("user_agent>='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) CodeChallenge_Equal(v string) *AuthorizationCodeQuery {
+	return q.w("code_challenge='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) CodeChallenge_NotEqual(v string) *AuthorizationCodeQuery {
+	return q.w("code_challenge<>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) CodeChallenge_Less(v string) *AuthorizationCodeQuery {
+	return q.w("code_challenge<'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) CodeChallenge_LessEqual(v string) *AuthorizationCodeQuery {
+	return q.w("code_challenge<='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) CodeChallenge_Greater(v string) *AuthorizationCodeQuery {
+	return q.w("code_challenge>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) CodeChallenge_GreaterEqual(v string) *AuthorizationCodeQuery {
+	return q.w("code_challenge>='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) CodeChallengeMethod_Equal(v string) *AuthorizationCodeQuery {
+	return q.w("code_challenge_method='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) CodeChallengeMethod_NotEqual(v string) *AuthorizationCodeQuery {
+	return q.w("code_challenge_method<>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) CodeChallengeMethod_Less(v string) *AuthorizationCodeQuery {
+	return q.w("code_challenge_method<'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) CodeChallengeMethod_LessEqual(v string) *AuthorizationCodeQuery {
+	return q.w("code_challenge_method<='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) CodeChallengeMethod_Greater(v string) *AuthorizationCodeQuery {
+	return q.w("code_challenge_method>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) CodeChallengeMethod_GreaterEqual(v string) *AuthorizationCodeQuery {
+	return q.w("code_challenge_method>='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) Used_Equal(v int32) *AuthorizationCodeQuery {
+	return q.w("used='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) Used_NotEqual(v int32) *AuthorizationCodeQuery {
+	return q.w("used<>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) Used_Less(v int32) *AuthorizationCodeQuery {
+	return q.w("used<'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) Used_LessEqual(v int32) *AuthorizationCodeQuery {
+	return q.w("used<='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) Used_Greater(v int32) *AuthorizationCodeQuery {
+	return q.w("used>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) Used_GreaterEqual(v int32) *AuthorizationCodeQuery {
+	return q.w("used>='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) Nonce_Equal(v string) *AuthorizationCodeQuery {
+	return q.w("nonce='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) Nonce_NotEqual(v string) *AuthorizationCodeQuery {
+	return q.w("nonce<>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) Nonce_Less(v string) *AuthorizationCodeQuery {
+	return q.w("nonce<'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) Nonce_LessEqual(v string) *AuthorizationCodeQuery {
+	return q.w("nonce<='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) Nonce_Greater(v string) *AuthorizationCodeQuery {
+	return q.w("nonce>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) Nonce_GreaterEqual(v string) *AuthorizationCodeQuery {
+	return q.w("nonce>='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) AuthTime_Equal(v int64) *AuthorizationCodeQuery {
+	return q.w("auth_time='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) AuthTime_NotEqual(v int64) *AuthorizationCodeQuery {
+	return q.w("auth_time<>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) AuthTime_Less(v int64) *AuthorizationCodeQuery {
+	return q.w("auth_time<'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) AuthTime_LessEqual(v int64) *AuthorizationCodeQuery {
+	return q.w("auth_time<='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) AuthTime_Greater(v int64) *AuthorizationCodeQuery {
+	return q.w("auth_time>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) AuthTime_GreaterEqual(v int64) *AuthorizationCodeQuery {
+	return q.w("auth_time>='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) ExpireTime_Equal(v int64) *AuthorizationCodeQuery {
+	return q.w("expire_time='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) ExpireTime_NotEqual(v int64) *AuthorizationCodeQuery {
+	return q.w("expire_time<>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) ExpireTime_Less(v int64) *AuthorizationCodeQuery {
+	return q.w("expire_time<'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) ExpireTime_LessEqual(v int64) *AuthorizationCodeQuery {
+	return q.w("expire_time<='" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) ExpireTime_Greater(v int64) *AuthorizationCodeQuery {
+	return q.w("expire_time>'" + fmt.Sprint(v) + "'")
+}
+func (q *AuthorizationCodeQuery) ExpireTime_GreaterEqual(v int64) *AuthorizationCodeQuery {
+	return q.w("expire_time>='" + fmt.Sprint(v) + "'")
+}
+
+type AuthorizationCodeDao struct {
+	logger     *zap.Logger
+	db         *DB
+	insertStmt *wrap.Stmt
+	updateStmt *wrap.Stmt
+	deleteStmt *wrap.Stmt
+}
+
+func NewAuthorizationCodeDao(db *DB) (t *AuthorizationCodeDao, err error) {
+	t = &AuthorizationCodeDao{}
+	t.logger = log.TypedLogger(t)
+	t.db = db
+	err = t.init()
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (dao *AuthorizationCodeDao) init() (err error) {
+	err = dao.prepareInsertStmt()
+	if err != nil {
+		return err
+	}
+
+	err = dao.prepareUpdateStmt()
+	if err != nil {
+		return err
+	}
+
+	err = dao.prepareDeleteStmt()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (dao *AuthorizationCodeDao) prepareInsertStmt() (err error) {
+	dao.insertStmt, err = dao.db.Prepare(context.Background(), "INSERT INTO authorization_code (authorization_code,client_id,account_id,redirect_uri,oauth_scope,expire_seconds,user_agent,code_challenge,code_challenge_method,used,nonce,auth_time,expire_time) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?)")
+	return err
+}
+
+func (dao *AuthorizationCodeDao) prepareUpdateStmt() (err error) {
+	dao.updateStmt, err = dao.db.Prepare(context.Background(), "UPDATE authorization_code SET authorization_code=?,client_id=?,account_id=?,redirect_uri=?,oauth_scope=?,expire_seconds=?,user_agent=?,code_challenge=?,code_challenge_method=?,used=?,nonce=?,auth_time=?,expire_time=? WHERE id=?")
+	return err
+}
+
+func (dao *AuthorizationCodeDao) prepareDeleteStmt() (err error) {
+	dao.deleteStmt, err = dao.db.Prepare(context.Background(), "DELETE FROM authorization_code WHERE id=?")
+	return err
+}
+
+func (dao *AuthorizationCodeDao) Insert(ctx context.Context, tx *wrap.Tx, e *AuthorizationCode) (id int64, err error) {
+	stmt := dao.insertStmt
+	if tx != nil {
+		stmt = tx.Stmt(ctx, stmt)
+	}
+
+	result, err := stmt.Exec(ctx, e.AuthorizationCode, e.ClientId, e.AccountId, e.RedirectUri, e.OauthScope, e.ExpireSeconds, e.UserAgent, e.CodeChallenge, e.CodeChallengeMethod, e.Used, e.Nonce, e.AuthTime, e.ExpireTime)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err = result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (dao *AuthorizationCodeDao) Update(ctx context.Context, tx *wrap.Tx, e *AuthorizationCode) (err error) {
+	stmt := dao.updateStmt
+	if tx != nil {
+		stmt = tx.Stmt(ctx, stmt)
+	}
+
+	_, err = stmt.Exec(ctx, e.AuthorizationCode, e.ClientId, e.AccountId, e.RedirectUri, e.OauthScope, e.ExpireSeconds, e.UserAgent, e.CodeChallenge, e.CodeChallengeMethod, e.Used, e.Nonce, e.AuthTime, e.ExpireTime, e.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (dao *AuthorizationCodeDao) Delete(ctx context.Context, tx *wrap.Tx, id uint64) (err error) {
+	stmt := dao.deleteStmt
+	if tx != nil {
+		stmt = tx.Stmt(ctx, stmt)
+	}
+
+	_, err = stmt.Exec(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (dao *AuthorizationCodeDao) scanRow(row *wrap.Row) (*AuthorizationCode, error) {
+	e := &AuthorizationCode{}
+	err := row.Scan(&e.Id, &e.AuthorizationCode, &e.ClientId, &e.AccountId, &e.RedirectUri, &e.OauthScope, &e.ExpireSeconds, &e.CreateTime, &e.UpdateTime, &e.UserAgent, &e.CodeChallenge, &e.CodeChallengeMethod, &e.Used, &e.Nonce, &e.AuthTime, &e.ExpireTime)
+	if err != nil {
+		if err == wrap.ErrNoRows {
+			return nil, nil
+		} else {
+			return nil, err
+		}
+	}
+
+	return e, nil
+}
+
+func (dao *AuthorizationCodeDao) scanRows(rows *wrap.Rows) (list []*AuthorizationCode, err error) {
+	list = make([]*AuthorizationCode, 0)
+	for rows.Next() {
+		e := AuthorizationCode{}
+		err = rows.Scan(&e.Id, &e.AuthorizationCode, &e.ClientId, &e.AccountId, &e.RedirectUri, &e.OauthScope, &e.ExpireSeconds, &e.CreateTime, &e.UpdateTime, &e.UserAgent, &e.CodeChallenge, &e.CodeChallengeMethod, &e.Used, &e.Nonce, &e.AuthTime, &e.ExpireTime)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, &e)
+	}
+	if rows.Err() != nil {
+		err = rows.Err()
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (dao *AuthorizationCodeDao) QueryOne(ctx context.Context, tx *wrap.Tx, query string) (*AuthorizationCode, error) {
+	querySql := "SELECT " + AUTHORIZATION_CODE_ALL_FIELDS_STRING + " FROM authorization_code " + query
+	var row *wrap.Row
+	if tx == nil {
+		row = dao.db.QueryRow(ctx, querySql)
+	} else {
+		row = tx.QueryRow(ctx, querySql)
+	}
+	return dao.scanRow(row)
+}
+
+func (dao *AuthorizationCodeDao) QueryList(ctx context.Context, tx *wrap.Tx, query string) (list []*AuthorizationCode, err error) {
+	querySql := "SELECT " + AUTHORIZATION_CODE_ALL_FIELDS_STRING + " FROM authorization_code " + query
+	var rows *wrap.Rows
+	if tx == nil {
+		rows, err = dao.db.Query(ctx, querySql)
+	} else {
+		rows, err = tx.Query(ctx, querySql)
+	}
+	if err != nil {
+		dao.logger.Error("sqlDriver", zap.Error(err))
+		return nil, err
+	}
+
+	return dao.scanRows(rows)
+}
+
+func (dao *AuthorizationCodeDao) QueryCount(ctx context.Context, tx *wrap.Tx, query string) (count int64, err error) {
+	querySql := "SELECT COUNT(1) FROM authorization_code " + query
+	var row *wrap.Row
+	if tx == nil {
+		row = dao.db.QueryRow(ctx, querySql)
+	} else {
+		row = tx.QueryRow(ctx, querySql)
+	}
+	if err != nil {
+		dao.logger.Error("sqlDriver", zap.Error(err))
+		return 0, err
+	}
+
+	err = row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *AuthorizationCodeDao) QueryGroupBy(ctx context.Context, tx *wrap.Tx, groupByFields []string, query string) (rows *wrap.Rows, err error) {
+	querySql := "SELECT " + strings.Join(groupByFields, ",") + ",count(1) FROM authorization_code " + query
+	if tx == nil {
+		return dao.db.Query(ctx, querySql)
+	} else {
+		return tx.Query(ctx, querySql)
+	}
+}
+
+func (dao *AuthorizationCodeDao) GetQuery() *AuthorizationCodeQuery {
+	return NewAuthorizationCodeQuery(dao)
+}
+
+const CLIENT_ASSERTION_TABLE_NAME = "client_assertion"
+
+type CLIENT_ASSERTION_FIELD string
+
+const CLIENT_ASSERTION_FIELD_ID = CLIENT_ASSERTION_FIELD("id")
+const CLIENT_ASSERTION_FIELD_CLIENT_ID = CLIENT_ASSERTION_FIELD("client_id")
+const CLIENT_ASSERTION_FIELD_JTI = CLIENT_ASSERTION_FIELD("jti")
+const CLIENT_ASSERTION_FIELD_EXPIRE_TIME = CLIENT_ASSERTION_FIELD("expire_time")
+const CLIENT_ASSERTION_FIELD_CREATE_TIME = CLIENT_ASSERTION_FIELD("create_time")
+const CLIENT_ASSERTION_FIELD_UPDATE_TIME = CLIENT_ASSERTION_FIELD("update_time")
+
+const CLIENT_ASSERTION_ALL_FIELDS_STRING = "id,client_id,jti,expire_time,create_time,update_time"
+
+var CLIENT_ASSERTION_ALL_FIELDS = []string{
+	"id",
+	"client_id",
+	"jti",
+	"expire_time",
+	"create_time",
+	"update_time",
+}
+
+type ClientAssertion struct {
+	Id         uint64 //size=20
+	ClientId   string //size=128
+	Jti        string //size=128
+	ExpireTime int64  //size=20
+	CreateTime time.Time
+	UpdateTime time.Time
+}
+
+type ClientAssertionQuery struct {
+	BaseQuery
+	dao *ClientAssertionDao
+}
+
+func NewClientAssertionQuery(dao *ClientAssertionDao) *ClientAssertionQuery {
+	q := &ClientAssertionQuery{}
+	q.dao = dao
+
+	return q
+}
+
+func (q *ClientAssertionQuery) QueryOne(ctx context.Context, tx *wrap.Tx) (*ClientAssertion, error) {
+	return q.dao.QueryOne(ctx, tx, q.buildQueryString())
+}
+
+func (q *ClientAssertionQuery) QueryList(ctx context.Context, tx *wrap.Tx) (list []*ClientAssertion, err error) {
+	return q.dao.QueryList(ctx, tx, q.buildQueryString())
+}
+
+func (q *ClientAssertionQuery) QueryCount(ctx context.Context, tx *wrap.Tx) (count int64, err error) {
+	return q.dao.QueryCount(ctx, tx, q.buildQueryString())
+}
+
+func (q *ClientAssertionQuery) QueryGroupBy(ctx context.Context, tx *wrap.Tx) (rows *wrap.Rows, err error) {
+	return q.dao.QueryGroupBy(ctx, tx, q.groupByFields, q.buildQueryString())
+}
+
+func (q *ClientAssertionQuery) ForUpdate() *ClientAssertionQuery {
+	q.forUpdate = true
+	return q
+}
+
+func (q *ClientAssertionQuery) ForShare() *ClientAssertionQuery {
+	q.forShare = true
+	return q
+}
+
+func (q *ClientAssertionQuery) GroupBy(fields ...CLIENT_ASSERTION_FIELD) *ClientAssertionQuery {
+	q.groupByFields = make([]string, len(fields))
+	for i, v := range fields {
+		q.groupByFields[i] = string(v)
+	}
+	return q
+}
+
+func (q *ClientAssertionQuery) Limit(startIncluded int64, count int64) *ClientAssertionQuery {
+	q.limit = fmt.Sprintf(" limit %d,%d", startIncluded, count)
+	return q
+}
+
+func (q *ClientAssertionQuery) OrderBy(fieldName CLIENT_ASSERTION_FIELD, asc bool) *ClientAssertionQuery {
+	if q.order != "" {
+		q.order += ","
+	}
+	q.order += string(fieldName) + " "
+	if asc {
+		q.order += "asc"
+	} else {
+		q.order += "desc"
+	}
+
+	return q
+}
+
+func (q *ClientAssertionQuery) OrderByGroupCount(asc bool) *ClientAssertionQuery {
+	if q.order != "" {
+		q.order += ","
+	}
+	q.order += "count(1) "
+	if asc {
+		q.order += "asc"
+	} else {
+		q.order += "desc"
+	}
+
+	return q
+}
+
+func (q *ClientAssertionQuery) w(format string, a ...interface{}) *ClientAssertionQuery {
+	q.where += fmt.Sprintf(format, a...)
+	return q
+}
+
+func (q *ClientAssertionQuery) Left() *ClientAssertionQuery  { return q.w(" ( ") }
+func (q *ClientAssertionQuery) Right() *ClientAssertionQuery { return q.w(" ) ") }
+func (q *ClientAssertionQuery) And() *ClientAssertionQuery   { return q.w(" AND ") }
+func (q *ClientAssertionQuery) Or() *ClientAssertionQuery    { return q.w(" OR ") }
+func (q *ClientAssertionQuery) Not() *ClientAssertionQuery   { return q.w(" NOT ") }
+
+func (q *ClientAssertionQuery) Id_Equal(v uint64) *ClientAssertionQuery {
+	return q.w("id='" + fmt.Sprint(v) + "'")
+}
+func (q *ClientAssertionQuery) Id_NotEqual(v uint64) *ClientAssertionQuery {
+	return q.w("id<>'" + fmt.Sprint(v) + "'")
+}
+func (q *ClientAssertionQuery) Id_Less(v uint64) *ClientAssertionQuery {
+	return q.w("id<'" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) AuthorizationCode_Greater(v string) *AuthorizationCodeQuery {
-	return q.w("authorization_code>'" + fmt.Sprint(v) + "'")
+func (q *ClientAssertionQuery) Id_LessEqual(v uint64) *ClientAssertionQuery {
+	return q.w("id<='" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) AuthorizationCode_GreaterEqual(v string) *AuthorizationCodeQuery {
-	return q.w("authorization_code>='" + fmt.Sprint(v) + "'")
+func (q *ClientAssertionQuery) Id_Greater(v uint64) *ClientAssertionQuery {
+	return q.w("id>'" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) ClientId_Equal(v string) *AuthorizationCodeQuery {
+func (q *ClientAssertionQuery) Id_GreaterEqual(v uint64) *ClientAssertionQuery {
+	return q.w("id>='" + fmt.Sprint(v) + "'")
+}
+func (q *ClientAssertionQuery) ClientId_Equal(v string) *ClientAssertionQuery {
 	return q.w("client_id='" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) ClientId_NotEqual(v string) *AuthorizationCodeQuery {
+func (q *ClientAssertionQuery) ClientId_NotEqual(v string) *ClientAssertionQuery {
 	return q.w("client_id<>'" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) ClientId_Less(v string) *AuthorizationCodeQuery {
+func (q *ClientAssertionQuery) ClientId_Less(v string) *ClientAssertionQuery {
 	return q.w("client_id<'" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) ClientId_LessEqual(v string) *AuthorizationCodeQuery {
+func (q *ClientAssertionQuery) ClientId_LessEqual(v string) *ClientAssertionQuery {
 	return q.w("client_id<='" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) ClientId_Greater(v string) *AuthorizationCodeQuery {
+func (q *ClientAssertionQuery) ClientId_Greater(v string) *ClientAssertionQuery {
 	return q.w("client_id>'" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) ClientId_GreaterEqual(v string) *AuthorizationCodeQuery {
+func (q *ClientAssertionQuery) ClientId_GreaterEqual(v string) *ClientAssertionQuery {
 	return q.w("client_id>='" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) AccountId_Equal(v string) *AuthorizationCodeQuery {
-	return q.w("account_id='" + fmt.Sprint(v) + "'")
-}
-func (q *AuthorizationCodeQuery) AccountId_NotEqual(v string) *AuthorizationCodeQuery {
-	return q.w("account_id<>'" + fmt.Sprint(v) + "'")
-}
-func (q *AuthorizationCodeQuery) AccountId_Less(v string) *AuthorizationCodeQuery {
-	return q.w("account_id<'" + fmt.Sprint(v) + "'")
-}
-func (q *AuthorizationCodeQuery) AccountId_LessEqual(v string) *AuthorizationCodeQuery {
-	return q.w("account_id<='" + fmt.Sprint(v) + "'")
-}
-func (q *AuthorizationCodeQuery) AccountId_Greater(v string) *AuthorizationCodeQuery {
-	return q.w("account_id>'" + fmt.Sprint(v) + "'")
-}
-func (q *AuthorizationCodeQuery) AccountId_GreaterEqual(v string) *AuthorizationCodeQuery {
-	return q.w("account_id>='" + fmt.Sprint(v) + "'")
-}
-func (q *AuthorizationCodeQuery) RedirectUri_Equal(v string) *AuthorizationCodeQuery {
-	return q.w("redirect_uri='" + fmt.Sprint(v) + "'")
-}
-func (q *AuthorizationCodeQuery) RedirectUri_NotEqual(v string) *AuthorizationCodeQuery {
-	return q.w("redirect_uri<>'" + fmt.Sprint(v) + "'")
-}
-func (q *AuthorizationCodeQuery) RedirectUri_Less(v string) *AuthorizationCodeQuery {
-	return q.w("redirect_uri<'" + fmt.Sprint(v) + "'")
-}
-func (q *AuthorizationCodeQuery) RedirectUri_LessEqual(v string) *AuthorizationCodeQuery {
-	return q.w("redirect_uri<='" + fmt.Sprint(v) + "'")
-}
-func (q *AuthorizationCodeQuery) RedirectUri_Greater(v string) *AuthorizationCodeQuery {
-	return q.w("redirect_uri>'" + fmt.Sprint(v) + "'")
-}
-func (q *AuthorizationCodeQuery) RedirectUri_GreaterEqual(v string) *AuthorizationCodeQuery {
-	return q.w("redirect_uri>='" + fmt.Sprint(v) + "'")
+func (q *ClientAssertionQuery) Jti_Equal(v string) *ClientAssertionQuery {
+	return q.w("jti='" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) OauthScope_Equal(v string) *AuthorizationCodeQuery {
-	return q.w("oauth_scope='" + fmt.Sprint(v) + "'")
-}
-func (q *AuthorizationCodeQuery) OauthScope_NotEqual(v string) *AuthorizationCodeQuery {
-	return q.w("oauth_scope<>'" + fmt.Sprint(v) + "'")
+func (q *ClientAssertionQuery) Jti_NotEqual(v string) *ClientAssertionQuery {
+	return q.w("jti<>'" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) OauthScope_Less(v string) *AuthorizationCodeQuery {
-	return q.w("oauth_scope<'" + fmt.Sprint(v) + "'")
+func (q *ClientAssertionQuery) Jti_Less(v string) *ClientAssertionQuery {
+	return q.w("jti<'" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) OauthScope_LessEqual(v string) *AuthorizationCodeQuery {
-	return q.w("oauth_scope<='" + fmt.Sprint(v) + "'")
+func (q *ClientAssertionQuery) Jti_LessEqual(v string) *ClientAssertionQuery {
+	return q.w("jti<='" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) OauthScope_Greater(v string) *AuthorizationCodeQuery {
-	return q.w("oauth_scope>'" + fmt.Sprint(v) + "'")
+func (q *ClientAssertionQuery) Jti_Greater(v string) *ClientAssertionQuery {
+	return q.w("jti>'" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) OauthScope_GreaterEqual(v string) *AuthorizationCodeQuery {
-	return q.w("oauth_scope>='" + fmt.Sprint(v) + "'")
+func (q *ClientAssertionQuery) Jti_GreaterEqual(v string) *ClientAssertionQuery {
+	return q.w("jti>='" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) ExpireSeconds_Equal(v int64) *AuthorizationCodeQuery {
-	return q.w("expire_seconds='" + fmt.Sprint(v) + "'")
+func (q *ClientAssertionQuery) ExpireTime_Equal(v int64) *ClientAssertionQuery {
+	return q.w("expire_time='" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) ExpireSeconds_NotEqual(v int64) *AuthorizationCodeQuery {
-	return q.w("expire_seconds<>'" + fmt.Sprint(v) + "'")
+func (q *ClientAssertionQuery) ExpireTime_NotEqual(v int64) *ClientAssertionQuery {
+	return q.w("expire_time<>'" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) ExpireSeconds_Less(v int64) *AuthorizationCodeQuery {
-	return q.w("expire_seconds<'" + fmt.Sprint(v) + "'")
+func (q *ClientAssertionQuery) ExpireTime_Less(v int64) *ClientAssertionQuery {
+	return q.w("expire_time<'" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) ExpireSeconds_LessEqual(v int64) *AuthorizationCodeQuery {
-	return q.w("expire_seconds<='" + fmt.Sprint(v) + "'")
+func (q *ClientAssertionQuery) ExpireTime_LessEqual(v int64) *ClientAssertionQuery {
+	return q.w("expire_time<='" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) ExpireSeconds_Greater(v int64) *AuthorizationCodeQuery {
-	return q.w("expire_seconds>'" + fmt.Sprint(v) + "'")
+func (q *ClientAssertionQuery) ExpireTime_Greater(v int64) *ClientAssertionQuery {
+	return q.w("expire_time>'" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) ExpireSeconds_GreaterEqual(v int64) *AuthorizationCodeQuery {
-	return q.w("expire_seconds>='" + fmt.Sprint(v) + "'")
+func (q *ClientAssertionQuery) ExpireTime_GreaterEqual(v int64) *ClientAssertionQuery {
+	return q.w("expire_time>='" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) CreateTime_Equal(v time.Time) *AuthorizationCodeQuery {
+func (q *ClientAssertionQuery) CreateTime_Equal(v time.Time) *ClientAssertionQuery {
 	return q.w("create_time='" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) CreateTime_NotEqual(v time.Time) *AuthorizationCodeQuery {
+func (q *ClientAssertionQuery) CreateTime_NotEqual(v time.Time) *ClientAssertionQuery {
 	return q.w("create_time<>'" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) CreateTime_Less(v time.Time) *AuthorizationCodeQuery {
+func (q *ClientAssertionQuery) CreateTime_Less(v time.Time) *ClientAssertionQuery {
 	return q.w("create_time<'" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) CreateTime_LessEqual(v time.Time) *AuthorizationCodeQuery {
+func (q *ClientAssertionQuery) CreateTime_LessEqual(v time.Time) *ClientAssertionQuery {
 	return q.w("create_time<='" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) CreateTime_Greater(v time.Time) *AuthorizationCodeQuery {
+func (q *ClientAssertionQuery) CreateTime_Greater(v time.Time) *ClientAssertionQuery {
 	return q.w("create_time>'" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) CreateTime_GreaterEqual(v time.Time) *AuthorizationCodeQuery {
+func (q *ClientAssertionQuery) CreateTime_GreaterEqual(v time.Time) *ClientAssertionQuery {
 	return q.w("create_time>='" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) UpdateTime_Equal(v time.Time) *AuthorizationCodeQuery {
+func (q *ClientAssertionQuery) UpdateTime_Equal(v time.Time) *ClientAssertionQuery {
 	return q.w("update_time='" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) UpdateTime_NotEqual(v time.Time) *AuthorizationCodeQuery {
+func (q *ClientAssertionQuery) UpdateTime_NotEqual(v time.Time) *ClientAssertionQuery {
 	return q.w("update_time<>'" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) UpdateTime_Less(v time.Time) *AuthorizationCodeQuery {
+func (q *ClientAssertionQuery) UpdateTime_Less(v time.Time) *ClientAssertionQuery {
 	return q.w("update_time<'" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) UpdateTime_LessEqual(v time.Time) *AuthorizationCodeQuery {
+func (q *ClientAssertionQuery) UpdateTime_LessEqual(v time.Time) *ClientAssertionQuery {
 	return q.w("update_time<='" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) UpdateTime_Greater(v time.Time) *AuthorizationCodeQuery {
+func (q *ClientAssertionQuery) UpdateTime_Greater(v time.Time) *ClientAssertionQuery {
 	return q.w("update_time>'" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) UpdateTime_GreaterEqual(v time.Time) *AuthorizationCodeQuery {
+func (q *ClientAssertionQuery) UpdateTime_GreaterEqual(v time.Time) *ClientAssertionQuery {
 	return q.w("update_time>='" + fmt.Sprint(v) + "'")
 }
-func (q *AuthorizationCodeQuery) UserAgent_Equal(v string) *AuthorizationCodeQuery {
-	return q.w("user_agent='" + fmt.Sprint(v) + "'")
-}
-func (q *AuthorizationCodeQuery) UserAgent_NotEqual(v string) *AuthorizationCodeQuery {
-	return q.w("user_agent<>'" + fmt.Sprint(v) + "'")
-}
-func (q *AuthorizationCodeQuery) UserAgent_Less(v string) *AuthorizationCodeQuery {
-	return q.w("user_agent<'" + fmt.Sprint(v) + "'")
-}
-func (q *AuthorizationCodeQuery) UserAgent_LessEqual(v string) *AuthorizationCodeQuery {
-	return q.w("user_agent<='" + fmt.Sprint(v) + "'")
-}
-func (q *AuthorizationCodeQuery) UserAgent_Greater(v string) *AuthorizationCodeQuery {
-	return q.w("user_agent>'" + fmt.Sprint(v) + "'")
-}
-func (q *AuthorizationCodeQuery) UserAgent_GreaterEqual(v string) *AuthorizationCodeQuery {
-	return q.w("user_agent>='" + fmt.Sprint(v) + "'")
-}
 
-type AuthorizationCodeDao struct {
+type ClientAssertionDao struct {
 	logger     *zap.Logger
 	db         *DB
 	insertStmt *wrap.Stmt
@@ -845,8 +1521,8 @@ type AuthorizationCodeDao struct {
 	deleteStmt *wrap.Stmt
 }
 
-func NewAuthorizationCodeDao(db *DB) (t *AuthorizationCodeDao, err error) {
-	t = &AuthorizationCodeDao{}
+func NewClientAssertionDao(db *DB) (t *ClientAssertionDao, err error) {
+	t = &ClientAssertionDao{}
 	t.logger = log.TypedLogger(t)
 	t.db = db
 	err = t.init()
@@ -857,7 +1533,7 @@ func NewAuthorizationCodeDao(db *DB) (t *AuthorizationCodeDao, err error) {
 	return t, nil
 }
 
-func (dao *AuthorizationCodeDao) init() (err error) {
+func (dao *ClientAssertionDao) init() (err error) {
 	err = dao.prepareInsertStmt()
 	if err != nil {
 		return err
@@ -876,28 +1552,28 @@ func (dao *AuthorizationCodeDao) init() (err error) {
 	return nil
 }
 
-func (dao *AuthorizationCodeDao) prepareInsertStmt() (err error) {
-	dao.insertStmt, err = dao.db.Prepare(context.Background(), "INSERT INTO authorization_code (authorization_code,client_id,account_id,redirect_uri,oauth_scope,expire_seconds,user_agent) VALUES (?,?,?,?,?,?,?)")
+func (dao *ClientAssertionDao) prepareInsertStmt() (err error) {
+	dao.insertStmt, err = dao.db.Prepare(context.Background(), "INSERT INTO client_assertion (client_id,jti,expire_time) VALUES (?,?,?)")
 	return err
 }
 
-func (dao *AuthorizationCodeDao) prepareUpdateStmt() (err error) {
-	dao.updateStmt, err = dao.db.Prepare(context.Background(), "UPDATE authorization_code SET authorization_code=?,client_id=?,account_id=?,redirect_uri=?,oauth_scope=?,expire_seconds=?,user_agent=? WHERE id=?")
+func (dao *ClientAssertionDao) prepareUpdateStmt() (err error) {
+	dao.updateStmt, err = dao.db.Prepare(context.Background(), "UPDATE client_assertion SET client_id=?,jti=?,expire_time=? WHERE id=?")
 	return err
 }
 
-func (dao *AuthorizationCodeDao) prepareDeleteStmt() (err error) {
-	dao.deleteStmt, err = dao.db.Prepare(context.Background(), "DELETE FROM authorization_code WHERE id=?")
+func (dao *ClientAssertionDao) prepareDeleteStmt() (err error) {
+	dao.deleteStmt, err = dao.db.Prepare(context.Background(), "DELETE FROM client_assertion WHERE id=?")
 	return err
 }
 
-func (dao *AuthorizationCodeDao) Insert(ctx context.Context, tx *wrap.Tx, e *AuthorizationCode) (id int64, err error) {
+func (dao *ClientAssertionDao) Insert(ctx context.Context, tx *wrap.Tx, e *ClientAssertion) (id int64, err error) {
 	stmt := dao.insertStmt
 	if tx != nil {
 		stmt = tx.Stmt(ctx, stmt)
 	}
 
-	result, err := stmt.Exec(ctx, e.AuthorizationCode, e.ClientId, e.AccountId, e.RedirectUri, e.OauthScope, e.ExpireSeconds, e.UserAgent)
+	result, err := stmt.Exec(ctx, e.ClientId, e.Jti, e.ExpireTime)
 	if err != nil {
 		return 0, err
 	}
@@ -910,13 +1586,13 @@ func (dao *AuthorizationCodeDao) Insert(ctx context.Context, tx *wrap.Tx, e *Aut
 	return id, nil
 }
 
-func (dao *AuthorizationCodeDao) Update(ctx context.Context, tx *wrap.Tx, e *AuthorizationCode) (err error) {
+func (dao *ClientAssertionDao) Update(ctx context.Context, tx *wrap.Tx, e *ClientAssertion) (err error) {
 	stmt := dao.updateStmt
 	if tx != nil {
 		stmt = tx.Stmt(ctx, stmt)
 	}
 
-	_, err = stmt.Exec(ctx, e.AuthorizationCode, e.ClientId, e.AccountId, e.RedirectUri, e.OauthScope, e.ExpireSeconds, e.UserAgent, e.Id)
+	_, err = stmt.Exec(ctx, e.ClientId, e.Jti, e.ExpireTime, e.Id)
 	if err != nil {
 		return err
 	}
@@ -924,7 +1600,7 @@ func (dao *AuthorizationCodeDao) Update(ctx context.Context, tx *wrap.Tx, e *Aut
 	return nil
 }
 
-func (dao *AuthorizationCodeDao) Delete(ctx context.Context, tx *wrap.Tx, id uint64) (err error) {
+func (dao *ClientAssertionDao) Delete(ctx context.Context, tx *wrap.Tx, id uint64) (err error) {
 	stmt := dao.deleteStmt
 	if tx != nil {
 		stmt = tx.Stmt(ctx, stmt)
@@ -938,9 +1614,9 @@ func (dao *AuthorizationCodeDao) Delete(ctx context.Context, tx *wrap.Tx, id uin
 	return nil
 }
 
-func (dao *AuthorizationCodeDao) scanRow(row *wrap.Row) (*AuthorizationCode, error) {
-	e := &AuthorizationCode{}
-	err := row.Scan(&e.Id, &e.AuthorizationCode, &e.ClientId, &e.AccountId, &e.RedirectUri, &e.OauthScope, &e.ExpireSeconds, &e.CreateTime, &e.UpdateTime, &e.UserAgent)
+func (dao *ClientAssertionDao) scanRow(row *wrap.Row) (*ClientAssertion, error) {
+	e := &ClientAssertion{}
+	err := row.Scan(&e.Id, &e.ClientId, &e.Jti, &e.ExpireTime, &e.CreateTime, &e.UpdateTime)
 	if err != nil {
 		if err == wrap.ErrNoRows {
 			return nil, nil
@@ -952,11 +1628,11 @@ func (dao *AuthorizationCodeDao) scanRow(row *wrap.Row) (*AuthorizationCode, err
 	return e, nil
 }
 
-func (dao *AuthorizationCodeDao) scanRows(rows *wrap.Rows) (list []*AuthorizationCode, err error) {
-	list = make([]*AuthorizationCode, 0)
+func (dao *ClientAssertionDao) scanRows(rows *wrap.Rows) (list []*ClientAssertion, err error) {
+	list = make([]*ClientAssertion, 0)
 	for rows.Next() {
-		e := AuthorizationCode{}
-		err = rows.Scan(&e.Id, &e.AuthorizationCode, &e.ClientId, &e.AccountId, &e.RedirectUri, &e.OauthScope, &e.ExpireSeconds, &e.CreateTime, &e.UpdateTime, &e.UserAgent)
+		e := ClientAssertion{}
+		err = rows.Scan(&e.Id, &e.ClientId, &e.Jti, &e.ExpireTime, &e.CreateTime, &e.UpdateTime)
 		if err != nil {
 			return nil, err
 		}
@@ -970,8 +1646,8 @@ func (dao *AuthorizationCodeDao) scanRows(rows *wrap.Rows) (list []*Authorizatio
 	return list, nil
 }
 
-func (dao *AuthorizationCodeDao) QueryOne(ctx context.Context, tx *wrap.Tx, query string) (*AuthorizationCode, error) {
-	querySql := "SELECT " + AUTHORIZATION_CODE_ALL_FIELDS_STRING + " FROM authorization_code " + query
+func (dao *ClientAssertionDao) QueryOne(ctx context.Context, tx *wrap.Tx, query string) (*ClientAssertion, error) {
+	querySql := "SELECT " + CLIENT_ASSERTION_ALL_FIELDS_STRING + " FROM client_assertion " + query
 	var row *wrap.Row
 	if tx == nil {
 		row = dao.db.QueryRow(ctx, querySql)
@@ -981,8 +1657,8 @@ func (dao *AuthorizationCodeDao) QueryOne(ctx context.Context, tx *wrap.Tx, quer
 	return dao.scanRow(row)
 }
 
-func (dao *AuthorizationCodeDao) QueryList(ctx context.Context, tx *wrap.Tx, query string) (list []*AuthorizationCode, err error) {
-	querySql := "SELECT " + AUTHORIZATION_CODE_ALL_FIELDS_STRING + " FROM authorization_code " + query
+func (dao *ClientAssertionDao) QueryList(ctx context.Context, tx *wrap.Tx, query string) (list []*ClientAssertion, err error) {
+	querySql := "SELECT " + CLIENT_ASSERTION_ALL_FIELDS_STRING + " FROM client_assertion " + query
 	var rows *wrap.Rows
 	if tx == nil {
 		rows, err = dao.db.Query(ctx, querySql)
@@ -997,8 +1673,8 @@ func (dao *AuthorizationCodeDao) QueryList(ctx context.Context, tx *wrap.Tx, que
 	return dao.scanRows(rows)
 }
 
-func (dao *AuthorizationCodeDao) QueryCount(ctx context.Context, tx *wrap.Tx, query string) (count int64, err error) {
-	querySql := "SELECT COUNT(1) FROM authorization_code " + query
+func (dao *ClientAssertionDao) QueryCount(ctx context.Context, tx *wrap.Tx, query string) (count int64, err error) {
+	querySql := "SELECT COUNT(1) FROM client_assertion " + query
 	var row *wrap.Row
 	if tx == nil {
 		row = dao.db.QueryRow(ctx, querySql)
@@ -1018,8 +1694,8 @@ func (dao *AuthorizationCodeDao) QueryCount(ctx context.Context, tx *wrap.Tx, qu
 	return count, nil
 }
 
-func (dao *AuthorizationCodeDao) QueryGroupBy(ctx context.Context, tx *wrap.Tx, groupByFields []string, query string) (rows *wrap.Rows, err error) {
-	querySql := "SELECT " + strings.Join(groupByFields, ",") + ",count(1) FROM authorization_code " + query
+func (dao *ClientAssertionDao) QueryGroupBy(ctx context.Context, tx *wrap.Tx, groupByFields []string, query string) (rows *wrap.Rows, err error) {
+	querySql := "SELECT " + strings.Join(groupByFields, ",") + ",count(1) FROM client_assertion " + query
 	if tx == nil {
 		return dao.db.Query(ctx, querySql)
 	} else {
@@ -1027,8 +1703,8 @@ func (dao *AuthorizationCodeDao) QueryGroupBy(ctx context.Context, tx *wrap.Tx,
 	}
 }
 
-func (dao *AuthorizationCodeDao) GetQuery() *AuthorizationCodeQuery {
-	return NewAuthorizationCodeQuery(dao)
+func (dao *ClientAssertionDao) GetQuery() *ClientAssertionQuery {
+	return NewClientAssertionQuery(dao)
 }
 
 const OAUTH_CLIENT_TABLE_NAME = "oauth_client"
@@ -1042,8 +1718,18 @@ const OAUTH_CLIENT_FIELD_PASSWORD_HASH = OAUTH_CLIENT_FIELD("password_hash")
 const OAUTH_CLIENT_FIELD_REDIRECT_URI = OAUTH_CLIENT_FIELD("redirect_uri")
 const OAUTH_CLIENT_FIELD_CREATE_TIME = OAUTH_CLIENT_FIELD("create_time")
 const OAUTH_CLIENT_FIELD_UPDATE_TIME = OAUTH_CLIENT_FIELD("update_time")
-
-const OAUTH_CLIENT_ALL_FIELDS_STRING = "id,client_id,account_id,password_hash,redirect_uri,create_time,update_time"
+const OAUTH_CLIENT_FIELD_OAUTH_SCOPE = OAUTH_CLIENT_FIELD("oauth_scope")
+const OAUTH_CLIENT_FIELD_CLIENT_TYPE = OAUTH_CLIENT_FIELD("client_type")
+const OAUTH_CLIENT_FIELD_GRANT_TYPES = OAUTH_CLIENT_FIELD("grant_types")
+const OAUTH_CLIENT_FIELD_DEFAULT_SCOPE = OAUTH_CLIENT_FIELD("default_scope")
+const OAUTH_CLIENT_FIELD_ACCESS_TOKEN_TTL = OAUTH_CLIENT_FIELD("access_token_ttl")
+const OAUTH_CLIENT_FIELD_REFRESH_TOKEN_TTL = OAUTH_CLIENT_FIELD("refresh_token_ttl")
+const OAUTH_CLIENT_FIELD_ISSUE_REFRESH_TOKEN = OAUTH_CLIENT_FIELD("issue_refresh_token")
+const OAUTH_CLIENT_FIELD_TOKEN_ENDPOINT_AUTH_METHOD = OAUTH_CLIENT_FIELD("token_endpoint_auth_method")
+const OAUTH_CLIENT_FIELD_JWKS = OAUTH_CLIENT_FIELD("jwks")
+const OAUTH_CLIENT_FIELD_TLS_CLIENT_AUTH_SUBJECT_DN = OAUTH_CLIENT_FIELD("tls_client_auth_subject_dn")
+
+const OAUTH_CLIENT_ALL_FIELDS_STRING = "id,client_id,account_id,password_hash,redirect_uri,create_time,update_time,oauth_scope,client_type,grant_types,default_scope,access_token_ttl,refresh_token_ttl,issue_refresh_token,token_endpoint_auth_method,jwks,tls_client_auth_subject_dn"
 
 var OAUTH_CLIENT_ALL_FIELDS = []string{
 	"id",
@@ -1053,16 +1739,36 @@ var OAUTH_CLIENT_ALL_FIELDS = []string{
 	"redirect_uri",
 	"create_time",
 	"update_time",
+	"oauth_scope",
+	"client_type",
+	"grant_types",
+	"default_scope",
+	"access_token_ttl",
+	"refresh_token_ttl",
+	"issue_refresh_token",
+	"token_endpoint_auth_method",
+	"jwks",
+	"tls_client_auth_subject_dn",
 }
 
 type OauthClient struct {
-	Id           uint64 //size=20
-	ClientId     string //size=128
-	AccountId    string //size=128
-	PasswordHash string //size=128
-	RedirectUri  string //size=256
-	CreateTime   time.Time
-	UpdateTime   time.Time
+	Id                      uint64 //size=20
+	ClientId                string //size=128
+	AccountId               string //size=128
+	PasswordHash            string //size=128
+	RedirectUri             string //size=2048
+	CreateTime              time.Time
+	UpdateTime              time.Time
+	OauthScope              string //size=1024
+	ClientType              string //size=32
+	GrantTypes              string //size=256
+	DefaultScope            string //size=1024
+	AccessTokenTtl          int64  //size=20
+	RefreshTokenTtl         int64  //size=20
+	IssueRefreshToken       int32  //size=11
+	TokenEndpointAuthMethod string //size=64
+	Jwks                    string
+	TlsClientAuthSubjectDn  string //size=1024
 }
 
 type OauthClientQuery struct {
@@ -1279,6 +1985,186 @@ func (q *OauthClientQuery) UpdateTime_Greater(v time.Time) *OauthClientQuery {
 func (q *OauthClientQuery) UpdateTime_GreaterEqual(v time.Time) *OauthClientQuery {
 	return q.w("update_time>='" + fmt.Sprint(v) + "'")
 }
+func (q *OauthClientQuery) OauthScope_Equal(v string) *OauthClientQuery {
+	return q.w("oauth_scope='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) OauthScope_NotEqual(v string) *OauthClientQuery {
+	return q.w("oauth_scope<>'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) OauthScope_Less(v string) *OauthClientQuery {
+	return q.w("oauth_scope<'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) OauthScope_LessEqual(v string) *OauthClientQuery {
+	return q.w("oauth_scope<='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) OauthScope_Greater(v string) *OauthClientQuery {
+	return q.w("oauth_scope>'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) OauthScope_GreaterEqual(v string) *OauthClientQuery {
+	return q.w("oauth_scope>='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) ClientType_Equal(v string) *OauthClientQuery {
+	return q.w("client_type='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) ClientType_NotEqual(v string) *OauthClientQuery {
+	return q.w("client_type<>'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) ClientType_Less(v string) *OauthClientQuery {
+	return q.w("client_type<'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) ClientType_LessEqual(v string) *OauthClientQuery {
+	return q.w("client_type<='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) ClientType_Greater(v string) *OauthClientQuery {
+	return q.w("client_type>'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) ClientType_GreaterEqual(v string) *OauthClientQuery {
+	return q.w("client_type>='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) GrantTypes_Equal(v string) *OauthClientQuery {
+	return q.w("grant_types='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) GrantTypes_NotEqual(v string) *OauthClientQuery {
+	return q.w("grant_types<>'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) GrantTypes_Less(v string) *OauthClientQuery {
+	return q.w("grant_types<'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) GrantTypes_LessEqual(v string) *OauthClientQuery {
+	return q.w("grant_types<='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) GrantTypes_Greater(v string) *OauthClientQuery {
+	return q.w("grant_types>'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) GrantTypes_GreaterEqual(v string) *OauthClientQuery {
+	return q.w("grant_types>='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) DefaultScope_Equal(v string) *OauthClientQuery {
+	return q.w("default_scope='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) DefaultScope_NotEqual(v string) *OauthClientQuery {
+	return q.w("default_scope<>'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) DefaultScope_Less(v string) *OauthClientQuery {
+	return q.w("default_scope<'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) DefaultScope_LessEqual(v string) *OauthClientQuery {
+	return q.w("default_scope<='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) DefaultScope_Greater(v string) *OauthClientQuery {
+	return q.w("default_scope>'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) DefaultScope_GreaterEqual(v string) *OauthClientQuery {
+	return q.w("default_scope>='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) AccessTokenTtl_Equal(v int64) *OauthClientQuery {
+	return q.w("access_token_ttl='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) AccessTokenTtl_NotEqual(v int64) *OauthClientQuery {
+	return q.w("access_token_ttl<>'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) AccessTokenTtl_Less(v int64) *OauthClientQuery {
+	return q.w("access_token_ttl<'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) AccessTokenTtl_LessEqual(v int64) *OauthClientQuery {
+	return q.w("access_token_ttl<='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) AccessTokenTtl_Greater(v int64) *OauthClientQuery {
+	return q.w("access_token_ttl>'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) AccessTokenTtl_GreaterEqual(v int64) *OauthClientQuery {
+	return q.w("access_token_ttl>='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) RefreshTokenTtl_Equal(v int64) *OauthClientQuery {
+	return q.w("refresh_token_ttl='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) RefreshTokenTtl_NotEqual(v int64) *OauthClientQuery {
+	return q.w("refresh_token_ttl<>'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) RefreshTokenTtl_Less(v int64) *OauthClientQuery {
+	return q.w("refresh_token_ttl<'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) RefreshTokenTtl_LessEqual(v int64) *OauthClientQuery {
+	return q.w("refresh_token_ttl<='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) RefreshTokenTtl_Greater(v int64) *OauthClientQuery {
+	return q.w("refresh_token_ttl>'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) RefreshTokenTtl_GreaterEqual(v int64) *OauthClientQuery {
+	return q.w("refresh_token_ttl>='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) IssueRefreshToken_Equal(v int32) *OauthClientQuery {
+	return q.w("issue_refresh_token='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) IssueRefreshToken_NotEqual(v int32) *OauthClientQuery {
+	return q.w("issue_refresh_token<>'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) IssueRefreshToken_Less(v int32) *OauthClientQuery {
+	return q.w("issue_refresh_token<'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) IssueRefreshToken_LessEqual(v int32) *OauthClientQuery {
+	return q.w("issue_refresh_token<='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) IssueRefreshToken_Greater(v int32) *OauthClientQuery {
+	return q.w("issue_refresh_token>'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) IssueRefreshToken_GreaterEqual(v int32) *OauthClientQuery {
+	return q.w("issue_refresh_token>='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) TokenEndpointAuthMethod_Equal(v string) *OauthClientQuery {
+	return q.w("token_endpoint_auth_method='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) TokenEndpointAuthMethod_NotEqual(v string) *OauthClientQuery {
+	return q.w("token_endpoint_auth_method<>'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) TokenEndpointAuthMethod_Less(v string) *OauthClientQuery {
+	return q.w("token_endpoint_auth_method<'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) TokenEndpointAuthMethod_LessEqual(v string) *OauthClientQuery {
+	return q.w("token_endpoint_auth_method<='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) TokenEndpointAuthMethod_Greater(v string) *OauthClientQuery {
+	return q.w("token_endpoint_auth_method>'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) TokenEndpointAuthMethod_GreaterEqual(v string) *OauthClientQuery {
+	return q.w("token_endpoint_auth_method>='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) Jwks_Equal(v string) *OauthClientQuery {
+	return q.w("jwks='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) Jwks_NotEqual(v string) *OauthClientQuery {
+	return q.w("jwks<>'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) Jwks_Less(v string) *OauthClientQuery {
+	return q.w("jwks<'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) Jwks_LessEqual(v string) *OauthClientQuery {
+	return q.w("jwks<='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) Jwks_Greater(v string) *OauthClientQuery {
+	return q.w("jwks>'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) Jwks_GreaterEqual(v string) *OauthClientQuery {
+	return q.w("jwks>='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) TlsClientAuthSubjectDn_Equal(v string) *OauthClientQuery {
+	return q.w("tls_client_auth_subject_dn='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) TlsClientAuthSubjectDn_NotEqual(v string) *OauthClientQuery {
+	return q.w("tls_client_auth_subject_dn<>'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) TlsClientAuthSubjectDn_Less(v string) *OauthClientQuery {
+	return q.w("tls_client_auth_subject_dn<'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) TlsClientAuthSubjectDn_LessEqual(v string) *OauthClientQuery {
+	return q.w("tls_client_auth_subject_dn<='" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) TlsClientAuthSubjectDn_Greater(v string) *OauthClientQuery {
+	return q.w("tls_client_auth_subject_dn>'" + fmt.Sprint(v) + "'")
+}
+func (q *OauthClientQuery) TlsClientAuthSubjectDn_GreaterEqual(v string) *OauthClientQuery {
+	return q.w("tls_client_auth_subject_dn>='" + fmt.Sprint(v) + "'")
+}
 
 type OauthClientDao struct {
 	logger     *zap.Logger
@@ -1320,12 +2206,12 @@ func (dao *OauthClientDao) init() (err error) {
 }
 
 func (dao *OauthClientDao) prepareInsertStmt() (err error) {
-	dao.insertStmt, err = dao.db.Prepare(context.Background(), "INSERT INTO oauth_client (client_id,account_id,password_hash,redirect_uri) VALUES (?,?,?,?)")
+	dao.insertStmt, err = dao.db.Prepare(context.Background(), "INSERT INTO oauth_client (client_id,account_id,password_hash,redirect_uri,oauth_scope,client_type,grant_types,default_scope,access_token_ttl,refresh_token_ttl,issue_refresh_token,token_endpoint_auth_method,jwks,tls_client_auth_subject_dn) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?)")
 	return err
 }
 
 func (dao *OauthClientDao) prepareUpdateStmt() (err error) {
-	dao.updateStmt, err = dao.db.Prepare(context.Background(), "UPDATE oauth_client SET client_id=?,account_id=?,password_hash=?,redirect_uri=? WHERE id=?")
+	dao.updateStmt, err = dao.db.Prepare(context.Background(), "UPDATE oauth_client SET client_id=?,account_id=?,password_hash=?,redirect_uri=?,oauth_scope=?,client_type=?,grant_types=?,default_scope=?,access_token_ttl=?,refresh_token_ttl=?,issue_refresh_token=?,token_endpoint_auth_method=?,jwks=?,tls_client_auth_subject_dn=? WHERE id=?")
 	return err
 }
 
@@ -1340,7 +2226,7 @@ func (dao *OauthClientDao) Insert(ctx context.Context, tx *wrap.Tx, e *OauthClie
 		stmt = tx.Stmt(ctx, stmt)
 	}
 
-	result, err := stmt.Exec(ctx, e.ClientId, e.AccountId, e.PasswordHash, e.RedirectUri)
+	result, err := stmt.Exec(ctx, e.ClientId, e.AccountId, e.PasswordHash, e.RedirectUri, e.OauthScope, e.ClientType, e.GrantTypes, e.DefaultScope, e.AccessTokenTtl, e.RefreshTokenTtl, e.IssueRefreshToken, e.TokenEndpointAuthMethod, e.Jwks, e.TlsClientAuthSubjectDn)
 	if err != nil {
 		return 0, err
 	}
@@ -1359,7 +2245,7 @@ func (dao *OauthClientDao) Update(ctx context.Context, tx *wrap.Tx, e *OauthClie
 		stmt = tx.Stmt(ctx, stmt)
 	}
 
-	_, err = stmt.Exec(ctx, e.ClientId, e.AccountId, e.PasswordHash, e.RedirectUri, e.Id)
+	_, err = stmt.Exec(ctx, e.ClientId, e.AccountId, e.PasswordHash, e.RedirectUri, e.OauthScope, e.ClientType, e.GrantTypes, e.DefaultScope, e.AccessTokenTtl, e.RefreshTokenTtl, e.IssueRefreshToken, e.TokenEndpointAuthMethod, e.Jwks, e.TlsClientAuthSubjectDn, e.Id)
 	if err != nil {
 		return err
 	}
@@ -1383,7 +2269,7 @@ func (dao *OauthClientDao) Delete(ctx context.Context, tx *wrap.Tx, id uint64) (
 
 func (dao *OauthClientDao) scanRow(row *wrap.Row) (*OauthClient, error) {
 	e := &OauthClient{}
-	err := row.Scan(&e.Id, &e.ClientId, &e.AccountId, &e.PasswordHash, &e.RedirectUri, &e.CreateTime, &e.UpdateTime)
+	err := row.Scan(&e.Id, &e.ClientId, &e.AccountId, &e.PasswordHash, &e.RedirectUri, &e.CreateTime, &e.UpdateTime, &e.OauthScope, &e.ClientType, &e.GrantTypes, &e.DefaultScope, &e.AccessTokenTtl, &e.RefreshTokenTtl, &e.IssueRefreshToken, &e.TokenEndpointAuthMethod, &e.Jwks, &e.TlsClientAuthSubjectDn)
 	if err != nil {
 		if err == wrap.ErrNoRows {
 			return nil, nil
@@ -1399,7 +2285,7 @@ func (dao *OauthClientDao) scanRows(rows *wrap.Rows) (list []*OauthClient, err e
 	list = make([]*OauthClient, 0)
 	for rows.Next() {
 		e := OauthClient{}
-		err = rows.Scan(&e.Id, &e.ClientId, &e.AccountId, &e.PasswordHash, &e.RedirectUri, &e.CreateTime, &e.UpdateTime)
+		err = rows.Scan(&e.Id, &e.ClientId, &e.AccountId, &e.PasswordHash, &e.RedirectUri, &e.CreateTime, &e.UpdateTime, &e.OauthScope, &e.ClientType, &e.GrantTypes, &e.DefaultScope, &e.AccessTokenTtl, &e.RefreshTokenTtl, &e.IssueRefreshToken, &e.TokenEndpointAuthMethod, &e.Jwks, &e.TlsClientAuthSubjectDn)
 		if err != nil {
 			return nil, err
 		}
@@ -1885,8 +2771,14 @@ const REFRESH_TOKEN_FIELD_EXPIRE_SECONDS = REFRESH_TOKEN_FIELD("expire_seconds")
 const REFRESH_TOKEN_FIELD_OAUTH_SCOPE = REFRESH_TOKEN_FIELD("oauth_scope")
 const REFRESH_TOKEN_FIELD_CREATE_TIME = REFRESH_TOKEN_FIELD("create_time")
 const REFRESH_TOKEN_FIELD_UPDATE_TIME = REFRESH_TOKEN_FIELD("update_time")
+const REFRESH_TOKEN_FIELD_AUTHORIZATION_CODE = REFRESH_TOKEN_FIELD("authorization_code")
+const REFRESH_TOKEN_FIELD_FAMILY_ID = REFRESH_TOKEN_FIELD("family_id")
+const REFRESH_TOKEN_FIELD_USED = REFRESH_TOKEN_FIELD("used")
+const REFRESH_TOKEN_FIELD_SESSION_EXPIRE_TIME = REFRESH_TOKEN_FIELD("session_expire_time")
+const REFRESH_TOKEN_FIELD_ISSUE_TIME = REFRESH_TOKEN_FIELD("issue_time")
+const REFRESH_TOKEN_FIELD_EXPIRE_TIME = REFRESH_TOKEN_FIELD("expire_time")
 
-const REFRESH_TOKEN_ALL_FIELDS_STRING = "id,refresh_token,client_id,account_id,expire_seconds,oauth_scope,create_time,update_time"
+const REFRESH_TOKEN_ALL_FIELDS_STRING = "id,refresh_token,client_id,account_id,expire_seconds,oauth_scope,create_time,update_time,authorization_code,family_id,used,session_expire_time,issue_time,expire_time"
 
 var REFRESH_TOKEN_ALL_FIELDS = []string{
 	"id",
@@ -1897,17 +2789,29 @@ var REFRESH_TOKEN_ALL_FIELDS = []string{
 	"oauth_scope",
 	"create_time",
 	"update_time",
+	"authorization_code",
+	"family_id",
+	"used",
+	"session_expire_time",
+	"issue_time",
+	"expire_time",
 }
 
 type RefreshToken struct {
-	Id            uint64 //size=20
-	RefreshToken  string //size=128
-	ClientId      string //size=128
-	AccountId     string //size=128
-	ExpireSeconds int64  //size=20
-	OauthScope    string //size=256
-	CreateTime    time.Time
-	UpdateTime    time.Time
+	Id                uint64 //size=20
+	RefreshToken      string //size=128
+	ClientId          string //size=128
+	AccountId         string //size=128
+	ExpireSeconds     int64  //size=20
+	OauthScope        string //size=256
+	CreateTime        time.Time
+	UpdateTime        time.Time
+	AuthorizationCode string //size=128
+	FamilyId          string //size=128
+	Used              int32  //size=11
+	SessionExpireTime int64  //size=20
+	IssueTime         int64  //size=20
+	ExpireTime        int64  //size=20
 }
 
 type RefreshTokenQuery struct {
@@ -2144,6 +3048,114 @@ func (q *RefreshTokenQuery) UpdateTime_Greater(v time.Time) *RefreshTokenQuery {
 func (q *RefreshTokenQuery) UpdateTime_GreaterEqual(v time.Time) *RefreshTokenQuery {
 	return q.w("update_time>='" + fmt.Sprint(v) + "'")
 }
+func (q *RefreshTokenQuery) AuthorizationCode_Equal(v string) *RefreshTokenQuery {
+	return q.w("authorization_code='" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) AuthorizationCode_NotEqual(v string) *RefreshTokenQuery {
+	return q.w("authorization_code<>'" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) AuthorizationCode_Less(v string) *RefreshTokenQuery {
+	return q.w("authorization_code<'" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) AuthorizationCode_LessEqual(v string) *RefreshTokenQuery {
+	return q.w("authorization_code<='" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) AuthorizationCode_Greater(v string) *RefreshTokenQuery {
+	return q.w("authorization_code>'" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) AuthorizationCode_GreaterEqual(v string) *RefreshTokenQuery {
+	return q.w("authorization_code>='" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) FamilyId_Equal(v string) *RefreshTokenQuery {
+	return q.w("family_id='" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) FamilyId_NotEqual(v string) *RefreshTokenQuery {
+	return q.w("family_id<>'" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) FamilyId_Less(v string) *RefreshTokenQuery {
+	return q.w("family_id<'" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) FamilyId_LessEqual(v string) *RefreshTokenQuery {
+	return q.w("family_id<='" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) FamilyId_Greater(v string) *RefreshTokenQuery {
+	return q.w("family_id>'" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) FamilyId_GreaterEqual(v string) *RefreshTokenQuery {
+	return q.w("family_id>='" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) Used_Equal(v int32) *RefreshTokenQuery {
+	return q.w("used='" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) Used_NotEqual(v int32) *RefreshTokenQuery {
+	return q.w("used<>'" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) Used_Less(v int32) *RefreshTokenQuery {
+	return q.w("used<'" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) Used_LessEqual(v int32) *RefreshTokenQuery {
+	return q.w("used<='" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) Used_Greater(v int32) *RefreshTokenQuery {
+	return q.w("used>'" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) Used_GreaterEqual(v int32) *RefreshTokenQuery {
+	return q.w("used>='" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) SessionExpireTime_Equal(v int64) *RefreshTokenQuery {
+	return q.w("session_expire_time='" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) SessionExpireTime_NotEqual(v int64) *RefreshTokenQuery {
+	return q.w("session_expire_time<>'" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) SessionExpireTime_Less(v int64) *RefreshTokenQuery {
+	return q.w("session_expire_time<'" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) SessionExpireTime_LessEqual(v int64) *RefreshTokenQuery {
+	return q.w("session_expire_time<='" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) SessionExpireTime_Greater(v int64) *RefreshTokenQuery {
+	return q.w("session_expire_time>'" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) SessionExpireTime_GreaterEqual(v int64) *RefreshTokenQuery {
+	return q.w("session_expire_time>='" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) IssueTime_Equal(v int64) *RefreshTokenQuery {
+	return q.w("issue_time='" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) IssueTime_NotEqual(v int64) *RefreshTokenQuery {
+	return q.w("issue_time<>'" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) IssueTime_Less(v int64) *RefreshTokenQuery {
+	return q.w("issue_time<'" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) IssueTime_LessEqual(v int64) *RefreshTokenQuery {
+	return q.w("issue_time<='" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) IssueTime_Greater(v int64) *RefreshTokenQuery {
+	return q.w("issue_time>'" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) IssueTime_GreaterEqual(v int64) *RefreshTokenQuery {
+	return q.w("issue_time>='" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) ExpireTime_Equal(v int64) *RefreshTokenQuery {
+	return q.w("expire_time='" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) ExpireTime_NotEqual(v int64) *RefreshTokenQuery {
+	return q.w("expire_time<>'" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) ExpireTime_Less(v int64) *RefreshTokenQuery {
+	return q.w("expire_time<'" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) ExpireTime_LessEqual(v int64) *RefreshTokenQuery {
+	return q.w("expire_time<='" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) ExpireTime_Greater(v int64) *RefreshTokenQuery {
+	return q.w("expire_time>'" + fmt.Sprint(v) + "'")
+}
+func (q *RefreshTokenQuery) ExpireTime_GreaterEqual(v int64) *RefreshTokenQuery {
+	return q.w("expire_time>='" + fmt.Sprint(v) + "'")
+}
 
 type RefreshTokenDao struct {
 	logger     *zap.Logger
@@ -2185,12 +3197,12 @@ func (dao *RefreshTokenDao) init() (err error) {
 }
 
 func (dao *RefreshTokenDao) prepareInsertStmt() (err error) {
-	dao.insertStmt, err = dao.db.Prepare(context.Background(), "INSERT INTO refresh_token (refresh_token,client_id,account_id,expire_seconds,oauth_scope) VALUES (?,?,?,?,?)")
+	dao.insertStmt, err = dao.db.Prepare(context.Background(), "INSERT INTO refresh_token (refresh_token,client_id,account_id,expire_seconds,oauth_scope,authorization_code,family_id,used,session_expire_time,issue_time,expire_time) VALUES (?,?,?,?,?,?,?,?,?,?,?)")
 	return err
 }
 
 func (dao *RefreshTokenDao) prepareUpdateStmt() (err error) {
-	dao.updateStmt, err = dao.db.Prepare(context.Background(), "UPDATE refresh_token SET refresh_token=?,client_id=?,account_id=?,expire_seconds=?,oauth_scope=? WHERE id=?")
+	dao.updateStmt, err = dao.db.Prepare(context.Background(), "UPDATE refresh_token SET refresh_token=?,client_id=?,account_id=?,expire_seconds=?,oauth_scope=?,authorization_code=?,family_id=?,used=?,session_expire_time=?,issue_time=?,expire_time=? WHERE id=?")
 	return err
 }
 
@@ -2205,7 +3217,7 @@ func (dao *RefreshTokenDao) Insert(ctx context.Context, tx *wrap.Tx, e *RefreshT
 		stmt = tx.Stmt(ctx, stmt)
 	}
 
-	result, err := stmt.Exec(ctx, e.RefreshToken, e.ClientId, e.AccountId, e.ExpireSeconds, e.OauthScope)
+	result, err := stmt.Exec(ctx, e.RefreshToken, e.ClientId, e.AccountId, e.ExpireSeconds, e.OauthScope, e.AuthorizationCode, e.FamilyId, e.Used, e.SessionExpireTime, e.IssueTime, e.ExpireTime)
 	if err != nil {
 		return 0, err
 	}
@@ -2224,7 +3236,7 @@ func (dao *RefreshTokenDao) Update(ctx context.Context, tx *wrap.Tx, e *RefreshT
 		stmt = tx.Stmt(ctx, stmt)
 	}
 
-	_, err = stmt.Exec(ctx, e.RefreshToken, e.ClientId, e.AccountId, e.ExpireSeconds, e.OauthScope, e.Id)
+	_, err = stmt.Exec(ctx, e.RefreshToken, e.ClientId, e.AccountId, e.ExpireSeconds, e.OauthScope, e.AuthorizationCode, e.FamilyId, e.Used, e.SessionExpireTime, e.IssueTime, e.ExpireTime, e.Id)
 	if err != nil {
 		return err
 	}
@@ -2248,7 +3260,7 @@ func (dao *RefreshTokenDao) Delete(ctx context.Context, tx *wrap.Tx, id uint64)
 
 func (dao *RefreshTokenDao) scanRow(row *wrap.Row) (*RefreshToken, error) {
 	e := &RefreshToken{}
-	err := row.Scan(&e.Id, &e.RefreshToken, &e.ClientId, &e.AccountId, &e.ExpireSeconds, &e.OauthScope, &e.CreateTime, &e.UpdateTime)
+	err := row.Scan(&e.Id, &e.RefreshToken, &e.ClientId, &e.AccountId, &e.ExpireSeconds, &e.OauthScope, &e.CreateTime, &e.UpdateTime, &e.AuthorizationCode, &e.FamilyId, &e.Used, &e.SessionExpireTime, &e.IssueTime, &e.ExpireTime)
 	if err != nil {
 		if err == wrap.ErrNoRows {
 			return nil, nil
@@ -2264,7 +3276,7 @@ func (dao *RefreshTokenDao) scanRows(rows *wrap.Rows) (list []*RefreshToken, err
 	list = make([]*RefreshToken, 0)
 	for rows.Next() {
 		e := RefreshToken{}
-		err = rows.Scan(&e.Id, &e.RefreshToken, &e.ClientId, &e.AccountId, &e.ExpireSeconds, &e.OauthScope, &e.CreateTime, &e.UpdateTime)
+		err = rows.Scan(&e.Id, &e.RefreshToken, &e.ClientId, &e.AccountId, &e.ExpireSeconds, &e.OauthScope, &e.CreateTime, &e.UpdateTime, &e.AuthorizationCode, &e.FamilyId, &e.Used, &e.SessionExpireTime, &e.IssueTime, &e.ExpireTime)
 		if err != nil {
 			return nil, err
 		}
@@ -2343,6 +3355,7 @@ type DB struct {
 	wrap.DB
 	AccessToken       *AccessTokenDao
 	AuthorizationCode *AuthorizationCodeDao
+	ClientAssertion   *ClientAssertionDao
 	OauthClient       *OauthClientDao
 	OauthScope        *OauthScopeDao
 	RefreshToken      *RefreshTokenDao
@@ -2377,6 +3390,11 @@ func NewDB() (d *DB, err error) {
 		return nil, err
 	}
 
+	d.ClientAssertion, err = NewClientAssertionDao(d)
+	if err != nil {
+		return nil, err
+	}
+
 	d.OauthClient, err = NewOauthClientDao(d)
 	if err != nil {
 		return nil, err
@@ -31,8 +31,17 @@ CREATE TABLE `access_token` (
   `oauth_scope` varchar(256) NOT NULL,
   `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  `authorization_code` varchar(128) NOT NULL DEFAULT '',
+  `refresh_token` varchar(128) NOT NULL DEFAULT '',
+  `cnf_x5t_s256` varchar(64) NOT NULL DEFAULT '',
+  `issue_time` bigint(20) NOT NULL DEFAULT '0',
+  `expire_time` bigint(20) NOT NULL DEFAULT '0',
+  `jti` varchar(128) NOT NULL DEFAULT '',
   PRIMARY KEY (`id`),
   UNIQUE KEY `idx_access_token` (`access_token`),
+  UNIQUE KEY `idx_jti` (`jti`),
+  KEY `idx_authorization_code` (`authorization_code`),
+  KEY `idx_refresh_token` (`refresh_token`),
   KEY `idx_update_time` (`update_time`),
   KEY `idx_client_account` (`client_id`,`account_id`),
   KEY `idx_account_id` (`account_id`)
@@ -57,6 +66,12 @@ CREATE TABLE `authorization_code` (
   `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
   `user_agent` varchar(256) NOT NULL,
+  `code_challenge` varchar(128) NOT NULL DEFAULT '',
+  `code_challenge_method` varchar(16) NOT NULL DEFAULT '',
+  `used` int(11) NOT NULL DEFAULT '0',
+  `nonce` varchar(256) NOT NULL DEFAULT '',
+  `auth_time` bigint(20) NOT NULL DEFAULT '0',
+  `expire_time` bigint(20) NOT NULL DEFAULT '0',
   PRIMARY KEY (`id`),
   UNIQUE KEY `idx_authorize_code` (`authorization_code`),
   KEY `idx_update_time` (`update_time`),
@@ -65,6 +80,26 @@ CREATE TABLE `authorization_code` (
 ) ENGINE=InnoDB AUTO_INCREMENT=1456 DEFAULT CHARSET=utf8;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `client_assertion`
+--
+
+DROP TABLE IF EXISTS `client_assertion`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `client_assertion` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `client_id` varchar(128) NOT NULL,
+  `jti` varchar(128) NOT NULL,
+  `expire_time` bigint(20) NOT NULL,
+  `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_client_jti` (`client_id`,`jti`),
+  KEY `idx_expire_time` (`expire_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `oauth_client`
 --
@@ -77,9 +112,19 @@ CREATE TABLE `oauth_client` (
   `client_id` varchar(128) NOT NULL,
   `account_id` varchar(128) NOT NULL,
   `password_hash` varchar(128) NOT NULL,
-  `redirect_uri` varchar(256) NOT NULL,
+  `redirect_uri` varchar(2048) NOT NULL,
   `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  `oauth_scope` varchar(1024) NOT NULL DEFAULT '',
+  `client_type` varchar(32) NOT NULL DEFAULT 'confidential',
+  `grant_types` varchar(256) NOT NULL DEFAULT '',
+  `default_scope` varchar(1024) NOT NULL DEFAULT '',
+  `access_token_ttl` bigint(20) NOT NULL DEFAULT '0',
+  `refresh_token_ttl` bigint(20) NOT NULL DEFAULT '0',
+  `issue_refresh_token` int(11) NOT NULL DEFAULT '1',
+  `token_endpoint_auth_method` varchar(64) NOT NULL DEFAULT 'client_secret_basic',
+  `jwks` text NOT NULL,
+  `tls_client_auth_subject_dn` varchar(1024) NOT NULL DEFAULT '',
   PRIMARY KEY (`id`),
   UNIQUE KEY `idx_client_id` (`client_id`),
   KEY `idx_account_id` (`account_id`),
@@ -122,8 +167,16 @@ CREATE TABLE `refresh_token` (
   `oauth_scope` varchar(256) NOT NULL,
   `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  `authorization_code` varchar(128) NOT NULL DEFAULT '',
+  `family_id` varchar(128) NOT NULL DEFAULT '',
+  `used` int(11) NOT NULL DEFAULT '0',
+  `session_expire_time` bigint(20) NOT NULL DEFAULT '0',
+  `issue_time` bigint(20) NOT NULL DEFAULT '0',
+  `expire_time` bigint(20) NOT NULL DEFAULT '0',
   PRIMARY KEY (`id`),
   UNIQUE KEY `idx_refresh_token` (`refresh_token`),
+  KEY `idx_authorization_code` (`authorization_code`),
+  KEY `idx_family_id` (`family_id`),
   KEY `idx_update_time` (`update_time`),
   KEY `idx_account_id` (`account_id`),
   KEY `idx_client_account` (`client_id`,`account_id`)